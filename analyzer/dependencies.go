@@ -0,0 +1,120 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Dependency is an interface a function depends on, declared in another file of its package.
+type Dependency struct {
+	Name string `json:"name"`
+	// the file of the package declaring it
+	File string `json:"file"`
+	Code string `json:"code"`
+}
+
+// typeDecl is a type declared in a file of the package.
+type typeDecl struct {
+	spec *ast.TypeSpec
+	file string
+	code string
+}
+
+// packageTypes lists the types declared in the non-test go files of the folder of path, in the package of file.
+func packageTypes(path string, file *ast.File) (map[string]*typeDecl, error) {
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	declared := map[string]*typeDecl{}
+
+	for _, other := range paths {
+		if strings.HasSuffix(other, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(other)
+		if err != nil {
+			return nil, err
+		}
+
+		fset := token.NewFileSet()
+
+		otherFile, err := parser.ParseFile(fset, other, src, parser.ParseComments)
+		if err != nil || otherFile.Name.Name != file.Name.Name {
+			// a broken or foreign file of the folder doesn't prevent analyzing this one
+			continue
+		}
+
+		for _, decl := range otherFile.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				spec := spec.(*ast.TypeSpec)
+
+				code := sourceOf(src, fset, genDecl, genDecl.Doc)
+				if genDecl.Lparen.IsValid() {
+					// a type of a type ( ... ) group
+					code = "type " + sourceOf(src, fset, spec, spec.Doc)
+				}
+
+				declared[spec.Name.Name] = &typeDecl{spec: spec, file: filepath.Base(other), code: code}
+			}
+		}
+	}
+
+	return declared, nil
+}
+
+// dependencies returns the interfaces fn depends on through its receiver and its parameters: the interfaces they
+// are, the ones their fields and the results of their func fields are, and so on, like the TaskDAOForWorkerTaskStarter
+// of the dependencies a Worker builds. Only the interfaces declared in another file than the one of fn are returned,
+// the others being given with its code already.
+func dependencies(fn *ast.FuncDecl, filename string, declared map[string]*typeDecl) []*Dependency {
+	var (
+		seen = map[string]bool{}
+		deps = []*Dependency{}
+	)
+
+	var visit func(node ast.Node)
+	visit = func(node ast.Node) {
+		ast.Inspect(node, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.SelectorExpr:
+				// the types of other packages
+				return false
+			case *ast.Ident:
+				decl, ok := declared[node.Name]
+				if !ok || seen[node.Name] {
+					return true
+				}
+
+				seen[node.Name] = true
+
+				if _, isInterface := decl.spec.Type.(*ast.InterfaceType); isInterface && decl.file != filename {
+					deps = append(deps, &Dependency{Name: node.Name, File: decl.file, Code: decl.code})
+				}
+
+				visit(decl.spec.Type)
+			}
+
+			return true
+		})
+	}
+
+	if fn.Recv != nil {
+		visit(fn.Recv)
+	}
+
+	visit(fn.Type.Params)
+
+	return deps
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestDependencies(t *testing.T) {
+	flagTestDependencies := []struct {
+		name string
+
+		files map[string]string
+
+		expectedDependencies map[string][]*Dependency
+	}{
+		{
+			name: "ok - interface of another file",
+
+			files: map[string]string{
+				"code.go": `package services
+
+type Service struct {
+	Getter Getter
+}
+
+func (s *Service) Get() error {
+	return s.Getter.Get()
+}
+`,
+				"interfaces.go": `package services
+
+// Getter gets.
+type Getter interface {
+	Get() error
+}
+`,
+			},
+
+			expectedDependencies: map[string][]*Dependency{
+				"Service.Get": {
+					{Name: "Getter", File: "interfaces.go", Code: "// Getter gets.\ntype Getter interface {\n\tGet() error\n}"},
+				},
+			},
+		},
+		{
+			name: "ok - interfaces of the dependencies a factory builds",
+
+			files: map[string]string{
+				"code.go": `package workers
+
+type Worker struct {
+	NewDependencies func() (*Dependencies, error)
+}
+
+func (w *Worker) Run() error {
+	return nil
+}
+`,
+				"dependencies.go": `package workers
+
+type (
+	Dependencies struct {
+		TaskDAO TaskDAO
+	}
+
+	TaskDAO interface {
+		GetNext() error
+	}
+)
+`,
+			},
+
+			expectedDependencies: map[string][]*Dependency{
+				"Worker.Run": {
+					{Name: "TaskDAO", File: "dependencies.go", Code: "type TaskDAO interface {\n\t\tGetNext() error\n\t}"},
+				},
+			},
+		},
+		{
+			name: "ok - interfaces of the file and of other packages left out",
+
+			files: map[string]string{
+				"code.go": `package services
+
+type Getter interface {
+	Get() error
+}
+
+func Run(getter Getter, ctx context.Context) error {
+	return getter.Get()
+}
+`,
+				"context.go": `package services
+
+type Context interface {
+	Done()
+}
+`,
+			},
+
+			expectedDependencies: map[string][]*Dependency{
+				"Run": {},
+			},
+		},
+	}
+
+	for _, tt := range flagTestDependencies {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := writeFiles(t, tt.files)
+
+			res, err := Functions(filepath.Join(dir, "code.go"))
+			assert.NoError(err)
+
+			dependencies := map[string][]*Dependency{}
+			for _, function := range res {
+				dependencies[function.Name] = function.Dependencies
+			}
+
+			assert.Equal(tt.expectedDependencies, dependencies)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	sqlWriteRegexp = regexp.MustCompile(`\b(INSERT|UPDATE|DELETE)\b`)
+	sqlReadRegexp  = regexp.MustCompile(`\bSELECT\b`)
+)
+
+// FileFeatures describes the shape of a go file, used to pick the example closest to it.
+type FileFeatures struct {
+	Package  string   `json:"package"`
+	Traits   []string `json:"traits"`
+	Branches int      `json:"branches"`
+	// the lines calling time.Now, which the tests can't freeze
+	TimeNowLines []int `json:"time_now_lines"`
+}
+
+// Features parses the go file at path and lists its traits: imports, kinds of dependencies held by its structs,
+// methods or functions, returned types, SQL statements, concurrency, time.Now calls, and its number of branches.
+// The lines of the time.Now calls are given too, for the model to be told about them.
+func Features(path string) (*FileFeatures, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	traits := map[string]struct{}{}
+	add := func(trait string) {
+		traits[trait] = struct{}{}
+	}
+
+	for _, imp := range file.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		add("import:" + importPath)
+
+		if importPath == "sync" || importPath == "golang.org/x/sync/errgroup" {
+			add("concurrency")
+		}
+	}
+
+	interfaces := declaredInterfaces(file)
+
+	var (
+		branches     = 0
+		timeNowLines = []int{}
+	)
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				for _, field := range structType.Fields.List {
+					if kind := dependencyKind(field.Type, interfaces); kind != "" {
+						add("field:" + kind)
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil {
+				add("receiver")
+			} else {
+				add("function")
+			}
+
+			if decl.Name.IsExported() && decl.Type.Results != nil {
+				for _, result := range decl.Type.Results.List {
+					add("returns:" + types.ExprString(result.Type))
+				}
+			}
+		}
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.IfStmt, *ast.CaseClause, *ast.CommClause:
+			branches++
+		case *ast.GoStmt:
+			add("concurrency")
+		case *ast.CallExpr:
+			if types.ExprString(node.Fun) == "time.Now" {
+				add("call:time.Now")
+				timeNowLines = append(timeNowLines, fset.Position(node.Pos()).Line)
+			}
+		case *ast.BasicLit:
+			if node.Kind == token.STRING {
+				addSQLTraits(node.Value, add)
+			}
+		}
+
+		return true
+	})
+
+	for _, embedded := range embeddedFiles(path, file) {
+		content, err := os.ReadFile(embedded)
+		if err != nil {
+			return nil, err
+		}
+
+		addSQLTraits(string(content), add)
+	}
+
+	features := &FileFeatures{
+		Package:  file.Name.Name,
+		Traits:   make([]string, 0, len(traits)),
+		Branches: branches,
+
+		TimeNowLines: timeNowLines,
+	}
+	for trait := range traits {
+		features.Traits = append(features.Traits, trait)
+	}
+
+	sort.Strings(features.Traits)
+
+	return features, nil
+}
+
+func declaredInterfaces(file *ast.File) map[string]bool {
+	interfaces := map[string]bool{}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if typeSpec, ok := node.(*ast.TypeSpec); ok {
+			if _, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				interfaces[typeSpec.Name.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	return interfaces
+}
+
+// dependencyKind tells what a struct field holds: a transaction, a factory, a clock, an http client,
+// or an interface declared in the file. It's empty for plain values.
+func dependencyKind(expr ast.Expr, interfaces map[string]bool) string {
+	typeName := types.ExprString(expr)
+
+	switch {
+	case strings.HasSuffix(typeName, "Tx") || strings.HasSuffix(typeName, "Conn"):
+		return "tx"
+	case typeName == "Clock" || strings.HasSuffix(typeName, ".Clock"):
+		return "clock"
+	case typeName == "*http.Client":
+		return "http"
+	case strings.HasSuffix(typeName, "Factory"):
+		return "factory"
+	case interfaces[typeName]:
+		return "interface"
+	}
+
+	if _, ok := expr.(*ast.FuncType); ok {
+		return "factory"
+	}
+
+	return ""
+}
+
+func addSQLTraits(code string, add func(string)) {
+	if sqlWriteRegexp.MatchString(code) {
+		add("sql:write")
+	}
+
+	if sqlReadRegexp.MatchString(code) {
+		add("sql:read")
+	}
+}
+
+// embeddedFiles resolves the //go:embed directives of the file, relative to its folder like the go tool does.
+func embeddedFiles(path string, file *ast.File) []string {
+	var paths []string
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			patterns, ok := strings.CutPrefix(comment.Text, "//go:embed ")
+			if !ok {
+				continue
+			}
+
+			for _, pattern := range strings.Fields(patterns) {
+				// all: also embeds the files starting with . or _ of the folders, which are all walked anyway
+				pattern = strings.TrimPrefix(strings.Trim(pattern, "\"`"), "all:")
+				matches, _ := filepath.Glob(filepath.Join(filepath.Dir(path), pattern))
+
+				for _, match := range matches {
+					_ = filepath.WalkDir(match, func(embedded string, entry os.DirEntry, err error) error {
+						if err == nil && !entry.IsDir() {
+							paths = append(paths, embedded)
+						}
+
+						return nil
+					})
+				}
+			}
+		}
+	}
+
+	return paths
+}
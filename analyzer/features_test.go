@@ -0,0 +1,181 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+// writeFiles writes the files of a package, given by name, into a temporary folder, and returns the folder.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestFeatures(t *testing.T) {
+	flagTestFeatures := []struct {
+		name string
+
+		files map[string]string
+
+		expectedRes *FileFeatures
+		expectErr   bool
+	}{
+		{
+			name: "ok",
+
+			files: map[string]string{
+				"code.go": `package services
+
+import (
+	"context"
+	"time"
+)
+
+type Getter interface {
+	Get(ctx context.Context) error
+}
+
+type Service struct {
+	Getter  Getter
+	Factory func() error
+}
+
+func (s *Service) Run(ctx context.Context) (time.Time, error) {
+	if err := s.Getter.Get(ctx); err != nil {
+		return time.Time{}, err
+	}
+
+	go func() {}()
+
+	// a time.Now() in a comment isn't a call
+	return time.Now(), nil
+}
+`,
+			},
+
+			expectedRes: &FileFeatures{
+				Package: "services",
+				Traits: []string{
+					"call:time.Now",
+					"concurrency",
+					"field:factory",
+					"field:interface",
+					"import:context",
+					"import:time",
+					"receiver",
+					"returns:error",
+					"returns:time.Time",
+				},
+				Branches: 1,
+
+				TimeNowLines: []int{25},
+			},
+		},
+		{
+			name: "ok - embedded sql",
+
+			files: map[string]string{
+				"code.go": `package dao
+
+import (
+	_ "embed"
+
+	"github.com/jackc/pgx/v5"
+)
+
+//go:embed queries/*.sql
+var queries string
+
+type Comment struct {
+	DB pgx.Tx
+}
+
+func NewComment(tx pgx.Tx) *Comment {
+	return &Comment{DB: tx}
+}
+`,
+				"queries/insert.sql": "INSERT INTO comments (content) VALUES ($1);",
+			},
+
+			expectedRes: &FileFeatures{
+				Package: "dao",
+				Traits: []string{
+					"field:tx",
+					"function",
+					"import:embed",
+					"import:github.com/jackc/pgx/v5",
+					"returns:*Comment",
+					"sql:write",
+				},
+				Branches: 0,
+
+				TimeNowLines: []int{},
+			},
+		},
+		{
+			name: "ok - embedded folder with all",
+
+			files: map[string]string{
+				"code.go": `package dao
+
+import _ "embed"
+
+//go:embed all:queries
+var queries string
+`,
+				"queries/_select.sql": "SELECT content FROM comments;",
+			},
+
+			expectedRes: &FileFeatures{
+				Package: "dao",
+				Traits: []string{
+					"import:embed",
+					"sql:read",
+				},
+				Branches: 0,
+
+				TimeNowLines: []int{},
+			},
+		},
+		{
+			name: "ko - invalid go",
+
+			files: map[string]string{
+				"code.go": "package dao\n\nfunc (",
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range flagTestFeatures {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := writeFiles(t, tt.files)
+
+			res, err := Features(filepath.Join(dir, "code.go"))
+			assert.Equal(tt.expectErr, err != nil)
+			assert.Equal(tt.expectedRes, res)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Function is an exported function or method of a file, with the code needed to test it on its own.
+type Function struct {
+	Name string `json:"name"`
+	// the package clause, the imports, the types, vars and consts of the file, the constructors,
+	// the function and the unexported functions it calls
+	Code string `json:"code"`
+	// the types of its parameters and results, one per value, to tell which generation modes it suits
+	Params  []string `json:"params"`
+	Results []string `json:"results"`
+	// the return paths of the function, each to be covered by a table case
+	Paths []*ReturnPath `json:"paths"`
+	// the interfaces it depends on that are declared in the other files of the package
+	Dependencies []*Dependency `json:"dependencies"`
+	// the structs it uses from the other files of the module and from its dependencies
+	Types []*TypeDefinition `json:"types"`
+}
+
+// Functions splits the go file at path into its exported functions and methods, so they can be tested one by one.
+// The constructors of the types having exported methods are given as context of those methods rather than tested.
+func Functions(path string) ([]*Function, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	declared, err := packageTypes(path, file)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		// the package clause and the imports
+		header = string(src[:fset.Position(file.Name.End()).Offset])
+
+		declarations []string
+		constructors = map[string][]string{}
+
+		funcs   []*ast.FuncDecl
+		helpers = map[string]*ast.FuncDecl{}
+		// the types having exported methods
+		receivers = map[string]bool{}
+	)
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				header = string(src[:fset.Position(decl.End()).Offset])
+				continue
+			}
+
+			declarations = append(declarations, sourceOf(src, fset, decl, decl.Doc))
+		case *ast.FuncDecl:
+			if !decl.Name.IsExported() {
+				helpers[decl.Name.Name] = decl
+				continue
+			}
+
+			funcs = append(funcs, decl)
+			if decl.Recv != nil {
+				receivers[receiverName(decl)] = true
+			}
+		}
+	}
+
+	var tested []*ast.FuncDecl
+
+	for _, fn := range funcs {
+		if built := constructedType(fn); built != "" && receivers[built] {
+			constructors[built] = append(constructors[built], sourceOf(src, fset, fn, fn.Doc))
+			continue
+		}
+
+		tested = append(tested, fn)
+	}
+
+	functions := make([]*Function, 0, len(tested))
+	loaded := loadFile(path)
+
+	for _, fn := range tested {
+		parts := append([]string{header}, declarations...)
+		if fn.Recv != nil {
+			parts = append(parts, constructors[receiverName(fn)]...)
+		}
+
+		parts = append(parts, sourceOf(src, fset, fn, fn.Doc))
+		for _, helper := range calledHelpers(fn, helpers) {
+			parts = append(parts, sourceOf(src, fset, helper, helper.Doc))
+		}
+
+		function := &Function{
+			Name:  functionName(fn),
+			Code:  strings.Join(parts, "\n\n") + "\n",
+			Paths: returnPaths(src, fset, fn),
+
+			Params:  fieldTypes(fn.Type.Params),
+			Results: fieldTypes(fn.Type.Results),
+
+			Dependencies: dependencies(fn, filepath.Base(path), declared),
+			Types:        []*TypeDefinition{},
+		}
+
+		if loaded != nil {
+			function.Types = loaded.usedStructs(function.Name)
+		}
+
+		functions = append(functions, function)
+	}
+
+	return functions, nil
+}
+
+func functionName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil {
+		return fn.Name.Name
+	}
+
+	return receiverName(fn) + "." + fn.Name.Name
+}
+
+// fieldTypes returns the types of a parameters or results list, one per value.
+func fieldTypes(list *ast.FieldList) []string {
+	typeNames := []string{}
+
+	if list != nil {
+		for _, field := range list.List {
+			// a, b T declares two values of T
+			for i := 0; i < max(len(field.Names), 1); i++ {
+				typeNames = append(typeNames, types.ExprString(field.Type))
+			}
+		}
+	}
+
+	return typeNames
+}
+
+// receiverName returns the name of the type of a method, without its pointer or type parameters.
+func receiverName(fn *ast.FuncDecl) string {
+	typeName := strings.TrimPrefix(types.ExprString(fn.Recv.List[0].Type), "*")
+	typeName, _, _ = strings.Cut(typeName, "[")
+
+	return typeName
+}
+
+// constructedType returns the type a NewXxx function builds, empty for the other functions.
+func constructedType(fn *ast.FuncDecl) string {
+	if fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "New") ||
+		fn.Type.Results == nil || len(fn.Type.Results.List) == 0 {
+		return ""
+	}
+
+	return strings.TrimPrefix(types.ExprString(fn.Type.Results.List[0].Type), "*")
+}
+
+// calledHelpers returns the unexported functions and methods of the file fn calls, directly or through other helpers.
+func calledHelpers(fn *ast.FuncDecl, helpers map[string]*ast.FuncDecl) []*ast.FuncDecl {
+	var (
+		called []*ast.FuncDecl
+		seen   = map[string]bool{}
+	)
+
+	var visit func(node ast.Node)
+	visit = func(node ast.Node) {
+		ast.Inspect(node, func(node ast.Node) bool {
+			var name string
+
+			switch node := node.(type) {
+			case *ast.Ident:
+				name = node.Name
+			case *ast.SelectorExpr:
+				name = node.Sel.Name
+			default:
+				return true
+			}
+
+			if helper, ok := helpers[name]; ok && !seen[name] {
+				seen[name] = true
+				called = append(called, helper)
+				visit(helper.Body)
+			}
+
+			return true
+		})
+	}
+
+	visit(fn.Body)
+
+	return called
+}
+
+// sourceOf returns the code of a declaration as written in src, with its doc comment.
+func sourceOf(src []byte, fset *token.FileSet, node ast.Node, doc *ast.CommentGroup) string {
+	start := node.Pos()
+	if doc != nil {
+		start = doc.Pos()
+	}
+
+	return string(src[fset.Position(start).Offset:fset.Position(node.End()).Offset])
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestFunctions(t *testing.T) {
+	flagTestFunctions := []struct {
+		name string
+
+		src string
+
+		expectedNames   []string
+		expectedCode    map[string]string
+		expectedParams  map[string][]string
+		expectedResults map[string][]string
+		expectErr       bool
+	}{
+		{
+			name: "ok",
+
+			src: `package services
+
+import "context"
+
+type Service struct {
+	Getter Getter
+}
+
+func NewService(getter Getter) *Service {
+	return &Service{Getter: getter}
+}
+
+// Get gets.
+func (s *Service) Get(ctx context.Context, a, b int) (int, error) {
+	return sum(a, b), nil
+}
+
+func Max(a, b int) int {
+	return max(a, b)
+}
+
+func sum(a, b int) int {
+	return add(a, b)
+}
+
+func add(a, b int) int {
+	return a + b
+}
+`,
+
+			expectedNames: []string{"Service.Get", "Max"},
+			expectedCode: map[string]string{
+				"Service.Get": `package services
+
+import "context"
+
+type Service struct {
+	Getter Getter
+}
+
+func NewService(getter Getter) *Service {
+	return &Service{Getter: getter}
+}
+
+// Get gets.
+func (s *Service) Get(ctx context.Context, a, b int) (int, error) {
+	return sum(a, b), nil
+}
+
+func sum(a, b int) int {
+	return add(a, b)
+}
+
+func add(a, b int) int {
+	return a + b
+}
+`,
+				"Max": `package services
+
+import "context"
+
+type Service struct {
+	Getter Getter
+}
+
+func Max(a, b int) int {
+	return max(a, b)
+}
+`,
+			},
+			expectedParams: map[string][]string{
+				"Service.Get": {"context.Context", "int", "int"},
+				"Max":         {"int", "int"},
+			},
+			expectedResults: map[string][]string{
+				"Service.Get": {"int", "error"},
+				"Max":         {"int"},
+			},
+		},
+		{
+			name: "ok - constructor of a type without methods tested",
+
+			src: `package entities
+
+type Task struct{}
+
+func NewTask() *Task {
+	return &Task{}
+}
+`,
+
+			expectedNames: []string{"NewTask"},
+			expectedParams: map[string][]string{
+				"NewTask": {},
+			},
+			expectedResults: map[string][]string{
+				"NewTask": {"*Task"},
+			},
+		},
+		{
+			name: "ok - constructor without results",
+
+			src: `package entities
+
+func NewTask() () {}
+`,
+
+			expectedNames: []string{"NewTask"},
+			expectedParams: map[string][]string{
+				"NewTask": {},
+			},
+			expectedResults: map[string][]string{
+				"NewTask": {},
+			},
+		},
+		{
+			name: "ko - invalid go",
+
+			src: "package services\n\nfunc (",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range flagTestFunctions {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := writeFiles(t, map[string]string{"code.go": tt.src})
+
+			res, err := Functions(filepath.Join(dir, "code.go"))
+			assert.Equal(tt.expectErr, err != nil)
+
+			if tt.expectErr {
+				return
+			}
+
+			names := []string{}
+			for _, function := range res {
+				names = append(names, function.Name)
+
+				if code, ok := tt.expectedCode[function.Name]; ok {
+					assert.Equal(code, function.Code)
+				}
+
+				assert.Equal(tt.expectedParams[function.Name], function.Params)
+				assert.Equal(tt.expectedResults[function.Name], function.Results)
+			}
+
+			assert.Equal(tt.expectedNames, names)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// database handles a DAO can hold
+var databaseTypes = map[string]bool{
+	"pgx.Tx":          true,
+	"pgx.Conn":        true,
+	"*pgx.Conn":       true,
+	"*pgxpool.Pool":   true,
+	"*pgxpool.Conn":   true,
+	"*sql.DB":         true,
+	"*sql.Tx":         true,
+	"*sql.Conn":       true,
+	"*sqlx.DB":        true,
+	"*sqlx.Tx":        true,
+	"pgxscan.Querier": true,
+}
+
+// FileLayer tells which examples a go file should learn from, and why.
+type FileLayer struct {
+	Layer  string `json:"layer"`
+	Reason string `json:"reason"`
+}
+
+// Layer classifies the go file at path from what it declares rather than from the folder it lives in.
+// The layer is empty when nothing in the file matches one.
+func Layer(path string) (*FileLayer, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// the interfaces the structs depend on are often declared in another file of the package
+	interfaces, err := packageInterfaces(fset, path, file)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range file.Comments {
+		if strings.Contains(group.Text(), "Code generated by Wire") {
+			return &FileLayer{Layer: "di", Reason: "generated by wire"}, nil
+		}
+	}
+
+	var (
+		funcs    []*ast.FuncDecl
+		fields   []*ast.Field
+		embedded []*ast.Field
+		// the structs whose fields are in fields and embedded
+		structs = map[string]bool{}
+	)
+
+	addFields := func(typeSpec *ast.TypeSpec) {
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok || structs[typeSpec.Name.Name] {
+			return
+		}
+
+		structs[typeSpec.Name.Name] = true
+
+		for _, field := range structType.Fields.List {
+			if len(field.Names) == 0 {
+				embedded = append(embedded, field)
+			} else {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			funcs = append(funcs, decl)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+					addFields(typeSpec)
+				}
+			}
+		}
+	}
+
+	// the methods of a struct declared in another file of the package, like the queries of a DAO split from its struct
+	declared, err := packageTypes(path, file)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fn := range funcs {
+		if fn.Recv == nil {
+			continue
+		}
+
+		if decl, ok := declared[receiverName(fn)]; ok {
+			addFields(decl.spec)
+		}
+	}
+
+	// the file-wide rules first: what a file exposes tells more than what its structs hold
+	for _, fn := range funcs {
+		switch {
+		case fn.Recv == nil && resultsAre(fn, "*cobra.Command"):
+			return &FileLayer{Layer: "commands", Reason: fn.Name.Name + " returns a *cobra.Command"}, nil
+		case fn.Recv != nil && fn.Name.Name == "Handle" && resultsAre(fn, "middleware.Responder"):
+			return &FileLayer{Layer: "handlers", Reason: fn.Name.Name + " returns a middleware.Responder"}, nil
+		case paramsAre(fn, "http.Handler") && resultsAre(fn, "http.Handler"):
+			return &FileLayer{Layer: "middlewares", Reason: fn.Name.Name + " wraps an http.Handler"}, nil
+		case fn.Recv != nil && fn.Name.Name == "Consume":
+			return &FileLayer{Layer: "consumers", Reason: fn.Name.Name + " consumes messages"}, nil
+		}
+	}
+
+	for _, field := range embedded {
+		typeName := strings.TrimPrefix(types.ExprString(field.Type), "*")
+		if _, name, _ := strings.Cut(typeName, "."); strings.HasPrefix(name, "Unimplemented") && strings.HasSuffix(name, "Server") {
+			return &FileLayer{Layer: "grpchandlers", Reason: "embeds " + typeName}, nil
+		}
+	}
+
+	for _, field := range fields {
+		typeName := types.ExprString(field.Type)
+
+		switch {
+		case typeName == "*http.Client":
+			return &FileLayer{Layer: "clients", Reason: "holds an *http.Client"}, nil
+		case databaseTypes[typeName]:
+			return &FileLayer{Layer: "dao", Reason: "holds a " + typeName}, nil
+		}
+	}
+
+	if sqlFile(path, file) {
+		return &FileLayer{Layer: "dao", Reason: "runs SQL queries"}, nil
+	}
+
+	for _, field := range fields {
+		if funcType, ok := field.Type.(*ast.FuncType); ok && returnsDependencies(funcType) {
+			return &FileLayer{Layer: "workers", Reason: "builds its dependencies with " + field.Names[0].Name}, nil
+		}
+	}
+
+	for _, field := range fields {
+		if typeName := types.ExprString(field.Type); interfaces[typeName] {
+			return &FileLayer{Layer: "services", Reason: "depends on the " + typeName + " interface"}, nil
+		}
+	}
+
+	// only plain functions left
+	exported := false
+	for _, fn := range funcs {
+		if fn.Recv != nil {
+			return &FileLayer{}, nil
+		}
+
+		exported = exported || fn.Name.IsExported()
+	}
+
+	if exported {
+		return &FileLayer{Layer: "utils", Reason: "only declares functions"}, nil
+	}
+
+	return &FileLayer{}, nil
+}
+
+// packageInterfaces lists the interfaces declared in the file and in the other non-test go files of its folder.
+func packageInterfaces(fset *token.FileSet, path string, file *ast.File) (map[string]bool, error) {
+	interfaces := declaredInterfaces(file)
+
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, other := range paths {
+		if strings.HasSuffix(other, "_test.go") || filepath.Clean(other) == filepath.Clean(path) {
+			continue
+		}
+
+		otherFile, err := parser.ParseFile(fset, other, nil, parser.SkipObjectResolution)
+		if err != nil || otherFile.Name.Name != file.Name.Name {
+			// a broken or foreign file of the folder doesn't prevent classifying this one
+			continue
+		}
+
+		for name := range declaredInterfaces(otherFile) {
+			interfaces[name] = true
+		}
+	}
+
+	return interfaces, nil
+}
+
+func resultsAre(fn *ast.FuncDecl, typeNames ...string) bool {
+	return fieldsAre(fn.Type.Results, typeNames)
+}
+
+func paramsAre(fn *ast.FuncDecl, typeNames ...string) bool {
+	return fieldsAre(fn.Type.Params, typeNames)
+}
+
+func fieldsAre(list *ast.FieldList, typeNames []string) bool {
+	return strings.Join(fieldTypes(list), ",") == strings.Join(typeNames, ",")
+}
+
+// returnsDependencies tells if a func type is a factory of dependencies, like func(ctx) (*XDependencies, error).
+func returnsDependencies(funcType *ast.FuncType) bool {
+	if funcType.Results == nil || len(funcType.Results.List) != 2 {
+		return false
+	}
+
+	_, isPointer := funcType.Results.List[0].Type.(*ast.StarExpr)
+
+	return isPointer && types.ExprString(funcType.Results.List[1].Type) == "error"
+}
+
+// sqlFile tells if the file holds SQL statements, in its strings or in the files it embeds.
+func sqlFile(path string, file *ast.File) bool {
+	hasSQL := false
+	add := func(string) {
+		hasSQL = true
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if lit, ok := node.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			addSQLTraits(lit.Value, add)
+		}
+
+		return !hasSQL
+	})
+
+	for _, embedded := range embeddedFiles(path, file) {
+		if content, err := os.ReadFile(embedded); err == nil {
+			addSQLTraits(string(content), add)
+		}
+	}
+
+	return hasSQL
+}
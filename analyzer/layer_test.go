@@ -0,0 +1,157 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestLayer(t *testing.T) {
+	flagTestLayer := []struct {
+		name string
+
+		files map[string]string
+
+		expectedRes *FileLayer
+		expectErr   bool
+	}{
+		{
+			name: "ok - handler",
+
+			files: map[string]string{
+				"code.go": `package api
+
+type GetApplication struct{}
+
+func (h *GetApplication) Handle(params GetApplicationParams, principal *User) middleware.Responder {
+	return nil
+}
+`,
+			},
+
+			expectedRes: &FileLayer{Layer: "handlers", Reason: "Handle returns a middleware.Responder"},
+		},
+		{
+			name: "ok - generated by wire",
+
+			files: map[string]string{
+				"code.go": `// Code generated by Wire. DO NOT EDIT.
+
+package di
+
+func InitDependencies() {}
+`,
+			},
+
+			expectedRes: &FileLayer{Layer: "di", Reason: "generated by wire"},
+		},
+		{
+			name: "ok - dao",
+
+			files: map[string]string{
+				"code.go": `package repository
+
+type Comment struct {
+	DB pgx.Tx
+}
+`,
+			},
+
+			expectedRes: &FileLayer{Layer: "dao", Reason: "holds a pgx.Tx"},
+		},
+		{
+			name: "ok - methods of a struct declared in another file",
+
+			files: map[string]string{
+				"code.go": `package repository
+
+func (c *Comment) Count(ctx context.Context) (int, error) {
+	return 0, nil
+}
+`,
+				"comment.go": `package repository
+
+type Comment struct {
+	DB *pgxpool.Pool
+}
+`,
+			},
+
+			expectedRes: &FileLayer{Layer: "dao", Reason: "holds a *pgxpool.Pool"},
+		},
+		{
+			name: "ok - service depending on an interface of another file",
+
+			files: map[string]string{
+				"code.go": `package domain
+
+type Service struct {
+	Getter Getter
+}
+`,
+				"interfaces.go": `package domain
+
+type Getter interface {
+	Get() error
+}
+`,
+			},
+
+			expectedRes: &FileLayer{Layer: "services", Reason: "depends on the Getter interface"},
+		},
+		{
+			name: "ok - plain functions",
+
+			files: map[string]string{
+				"code.go": `package helpers
+
+func Max(a, b int) int {
+	return max(a, b)
+}
+`,
+			},
+
+			expectedRes: &FileLayer{Layer: "utils", Reason: "only declares functions"},
+		},
+		{
+			name: "ok - no layer",
+
+			files: map[string]string{
+				"code.go": `package helpers
+
+type Options struct{}
+
+func (o Options) String() string {
+	return ""
+}
+`,
+			},
+
+			expectedRes: &FileLayer{},
+		},
+		{
+			name: "ko - invalid go",
+
+			files: map[string]string{
+				"code.go": "package helpers\n\nfunc (",
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range flagTestLayer {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := writeFiles(t, tt.files)
+
+			res, err := Layer(filepath.Join(dir, "code.go"))
+			assert.Equal(tt.expectErr, err != nil)
+			assert.Equal(tt.expectedRes, res)
+		})
+	}
+}
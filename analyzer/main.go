@@ -0,0 +1,56 @@
+// Command analyzer inspects go files with go/ast for the test generator, and prints what it found as JSON.
+//
+//	go run . features FILE
+//	go run . layer FILE
+//	go run . functions FILE
+//	go run . untested FILE TEST_FILE Test|Fuzz|Benchmark
+//	go run . merge FILE...
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const usage = "usage: analyzer features|layer|functions FILE, analyzer untested FILE TEST_FILE PREFIX, or analyzer merge FILE..."
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var (
+		command, args = os.Args[1], os.Args[2:]
+
+		res interface{}
+		err error
+	)
+
+	switch {
+	case command == "features" && len(args) == 1:
+		res, err = Features(args[0])
+	case command == "layer" && len(args) == 1:
+		res, err = Layer(args[0])
+	case command == "functions" && len(args) == 1:
+		res, err = Functions(args[0])
+	case command == "untested" && len(args) == 3:
+		res, err = Untested(args[0], args[1], args[2])
+	case command == "merge":
+		res, err = Merge(args)
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err = json.NewEncoder(os.Stdout).Encode(res); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// MergedTests is the single test file built from the tests generated function by function.
+type MergedTests struct {
+	Source string `json:"source"`
+	// the generated files left out, with why
+	Skipped []string `json:"skipped"`
+}
+
+// Merge merges the test files at paths into a single one: the first valid file is kept as is, and the declarations
+// of the others are added after its own, the imports they use joining its import block. The files that don't parse are
+// skipped rather than breaking the whole output, and a declaration already made by a previous file, like a shared helper,
+// is kept only once, the dropped ones being reported. A file binding an import name to another package than a previous
+// file is skipped too, as it wouldn't compile. Formatting the result leaves the first file untouched when it's already
+// gofmt'ed, so existing tests can be appended to.
+func Merge(paths []string) (*MergedTests, error) {
+	var (
+		merged = &MergedTests{Skipped: []string{}}
+
+		packageName string
+		header      string
+		// the first file after its imports
+		body string
+		// where the import block of the first file opens and closes in header, to add the other imports to it
+		lparen, rparen int
+		// the imports by name and path, and the path each name is bound to
+		imported   = map[string]bool{}
+		bindings   = map[string]string{}
+		stdImports []string
+		newImports []string
+
+		declared = map[string]bool{}
+		decls    []string
+	)
+
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		fset := token.NewFileSet()
+
+		file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if err != nil {
+			merged.Skipped = append(merged.Skipped, err.Error())
+			continue
+		}
+
+		first := packageName == ""
+		if first {
+			packageName = file.Name.Name
+			header = string(src[:fset.Position(file.Name.End()).Offset])
+		} else if file.Name.Name != packageName {
+			merged.Skipped = append(merged.Skipped, fmt.Sprintf("%s: package %s instead of %s", path, file.Name.Name, packageName))
+			continue
+		}
+
+		var (
+			// the declarations of the file to add after the ones of the previous files, and the names they declare
+			fileDecls []string
+			pending   = map[string]bool{}
+			// the packages the kept declarations of the file refer to, the imports of the dropped ones being left out
+			used        = map[string]bool{}
+			fileSkipped []string
+		)
+
+		for _, decl := range file.Decls {
+			var names []string
+
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				if decl.Tok == token.IMPORT {
+					if first {
+						header = string(src[:fset.Position(decl.End()).Offset])
+						if decl.Lparen.IsValid() {
+							lparen, rparen = fset.Position(decl.Lparen).Offset+1, fset.Position(decl.Rparen).Offset
+						}
+					}
+
+					continue
+				}
+
+				names = declaredNames(decl)
+			case *ast.FuncDecl:
+				names = []string{functionName(decl)}
+			default:
+				continue
+			}
+
+			if anyDeclared(names, declared, pending) {
+				fileSkipped = append(fileSkipped, fmt.Sprintf("%s: %s already declared", path, strings.Join(names, ", ")))
+				continue
+			}
+
+			if !first {
+				fileDecls = append(fileDecls, sourceOf(src, fset, decl, declDoc(decl)))
+				addQualifiers(used, decl)
+			}
+		}
+
+		// the imports are told apart by the name they bind and their path, however they're written
+		var (
+			fileImports []*ast.ImportSpec
+			conflict    string
+		)
+
+		for _, spec := range file.Imports {
+			name, importPath := importName(spec), strings.Trim(spec.Path.Value, `"`)
+			if !first && name != "_" && name != "." && !used[name] {
+				continue
+			}
+
+			if imported[name+" "+importPath] {
+				continue
+			}
+
+			if bound, ok := bindings[name]; ok && name != "_" && name != "." {
+				conflict = fmt.Sprintf("%s: %s imports %s while it's already %s", path, name, importPath, bound)
+				break
+			}
+
+			fileImports = append(fileImports, spec)
+		}
+
+		// a name bound to two packages wouldn't compile
+		if conflict != "" {
+			merged.Skipped = append(merged.Skipped, conflict)
+			continue
+		}
+
+		merged.Skipped = append(merged.Skipped, fileSkipped...)
+		decls = append(decls, fileDecls...)
+
+		for name := range pending {
+			declared[name] = true
+		}
+
+		for _, spec := range fileImports {
+			name, importPath := importName(spec), strings.Trim(spec.Path.Value, `"`)
+
+			imported[name+" "+importPath] = true
+			bindings[name] = importPath
+
+			importSpec := sourceOf(src, fset, spec, nil)
+
+			switch {
+			case first:
+			case standardLibrary(importPath):
+				stdImports = append(stdImports, importSpec)
+			default:
+				newImports = append(newImports, importSpec)
+			}
+		}
+
+		if first {
+			body = strings.TrimSpace(string(src[len(header):]))
+		}
+	}
+
+	if packageName == "" {
+		return nil, fmt.Errorf("none of the %d generated files is valid go", len(paths))
+	}
+
+	switch {
+	case len(stdImports)+len(newImports) == 0:
+	case rparen == 0:
+		header += "\n\nimport (\n\t" + strings.Join(append(stdImports, newImports...), "\n\t") + "\n)"
+	default:
+		// the standard library joins the first group, the other imports come in a group of their own
+		std, others := "", ""
+		if len(stdImports) > 0 {
+			std = "\n\t" + strings.Join(stdImports, "\n\t")
+		}
+
+		if len(newImports) > 0 {
+			others = "\n\t" + strings.Join(newImports, "\n\t") + "\n"
+		}
+
+		header = header[:lparen] + std + header[lparen:rparen] + others + header[rparen:]
+	}
+
+	source, err := format.Source([]byte(header + "\n\n" + body + "\n\n" + strings.Join(decls, "\n\n") + "\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	merged.Source = string(source)
+
+	return merged, nil
+}
+
+// anyDeclared tells if one of the names is already declared, by a previous file or by the file being merged, and
+// declares them in the file otherwise.
+func anyDeclared(names []string, declared, pending map[string]bool) bool {
+	for _, name := range names {
+		if declared[name] || pending[name] {
+			return true
+		}
+	}
+
+	for _, name := range names {
+		pending[name] = true
+	}
+
+	return false
+}
+
+func declaredNames(decl *ast.GenDecl) []string {
+	var names []string
+
+	for _, spec := range decl.Specs {
+		switch spec := spec.(type) {
+		case *ast.TypeSpec:
+			names = append(names, spec.Name.Name)
+		case *ast.ValueSpec:
+			for _, name := range spec.Names {
+				if name.Name != "_" {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch decl := decl.(type) {
+	case *ast.GenDecl:
+		return decl.Doc
+	case *ast.FuncDecl:
+		return decl.Doc
+	}
+
+	return nil
+}
+
+// addQualifiers adds the names a declaration qualifies identifiers with, like the strconv of strconv.Itoa. The fields
+// and methods of its variables are added too, which only keeps an import that isn't needed when they share its name.
+func addQualifiers(used map[string]bool, decl ast.Decl) {
+	ast.Inspect(decl, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+
+		return true
+	})
+}
+
+// importName returns the name a file refers to an import by: its explicit name, or the last element of its path without
+// its major version, its go- prefix or -go suffix, like pgx for github.com/jackc/pgx/v5 or yaml for gopkg.in/yaml.v3.
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+
+	elements := strings.Split(strings.Trim(spec.Path.Value, `"`), "/")
+
+	name := elements[len(elements)-1]
+	if len(elements) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = elements[len(elements)-2]
+	}
+
+	name, _, _ = strings.Cut(name, ".")
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "go-"), "-go")
+
+	return strings.ReplaceAll(name, "-", "")
+}
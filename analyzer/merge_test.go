@@ -0,0 +1,272 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	flagTestMerge := []struct {
+		name string
+
+		files []string
+
+		expectedSource  string
+		expectedSkipped []string
+		expectErr       bool
+	}{
+		{
+			name: "ok",
+
+			files: []string{
+				`package utils_test
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestA(t *testing.T) {
+	tassert.True(t, true)
+}
+`,
+				`package utils_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestB(t *testing.T) {
+	_ = strconv.Itoa(1)
+	_ = uuid.New()
+}
+`,
+			},
+
+			expectedSource: `package utils_test
+
+import (
+	"strconv"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/google/uuid"
+)
+
+func TestA(t *testing.T) {
+	tassert.True(t, true)
+}
+
+func TestB(t *testing.T) {
+	_ = strconv.Itoa(1)
+	_ = uuid.New()
+}
+`,
+			expectedSkipped: []string{},
+		},
+		{
+			name: "ok - imports of the dropped declarations left out",
+
+			files: []string{
+				`package utils_test
+
+import "testing"
+
+func helper() int {
+	return 1
+}
+
+func TestA(t *testing.T) {
+	_ = helper()
+}
+`,
+				`package utils_test
+
+import (
+	"strconv"
+	"testing"
+)
+
+func helper() int {
+	n, _ := strconv.Atoi("1")
+	return n
+}
+
+func TestA(t *testing.T) {}
+
+func TestB(t *testing.T) {
+	_ = helper()
+}
+`,
+			},
+
+			expectedSource: `package utils_test
+
+import "testing"
+
+func helper() int {
+	return 1
+}
+
+func TestA(t *testing.T) {
+	_ = helper()
+}
+
+func TestB(t *testing.T) {
+	_ = helper()
+}
+`,
+			expectedSkipped: []string{
+				"1_test.go: helper already declared",
+				"1_test.go: TestA already declared",
+			},
+		},
+		{
+			name: "ok - imports named differently or bound to another package",
+
+			files: []string{
+				`package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestA(t *testing.T) {
+	_ = mock.Anything
+}
+`,
+				`package utils_test
+
+import (
+	"testing"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestB(t *testing.T) {
+	_ = mock.Anything
+}
+`,
+				`package utils_test
+
+import (
+	"testing"
+
+	mock "github.com/golang/mock/gomock"
+)
+
+func TestC(t *testing.T) {
+	_ = mock.Any()
+}
+`,
+			},
+
+			expectedSource: `package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestA(t *testing.T) {
+	_ = mock.Anything
+}
+
+func TestB(t *testing.T) {
+	_ = mock.Anything
+}
+`,
+			expectedSkipped: []string{
+				"2_test.go: mock imports github.com/golang/mock/gomock while it's already github.com/stretchr/testify/mock",
+			},
+		},
+		{
+			name: "ok - invalid and foreign files skipped",
+
+			files: []string{
+				`package utils_test
+
+func (
+`,
+				`package utils_test
+
+import "testing"
+
+func TestA(t *testing.T) {}
+`,
+				`package other
+
+func TestB() {}
+`,
+			},
+
+			expectedSource: `package utils_test
+
+import "testing"
+
+func TestA(t *testing.T) {}
+`,
+			expectedSkipped: []string{
+				"0_test.go:3:8: expected ')', found 'EOF'",
+				"2_test.go: package other instead of utils_test",
+			},
+		},
+		{
+			name: "ko - no valid file",
+
+			files: []string{
+				`package utils_test
+
+func (
+`,
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range flagTestMerge {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := t.TempDir()
+
+			var paths []string
+			for i, file := range tt.files {
+				paths = append(paths, filepath.Join(dir, fmt.Sprintf("%d_test.go", i)))
+				if err := os.WriteFile(paths[i], []byte(file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			res, err := Merge(paths)
+			assert.Equal(tt.expectErr, err != nil)
+
+			if !tt.expectErr {
+				assert.Equal(tt.expectedSource, res.Source)
+
+				skipped := []string{}
+				for _, reason := range res.Skipped {
+					skipped = append(skipped, strings.TrimPrefix(reason, dir+string(os.PathSeparator)))
+				}
+
+				assert.Equal(tt.expectedSkipped, skipped)
+			}
+		})
+	}
+}
@@ -0,0 +1,613 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ReturnPath is a way out of a function, that a table case of its tests should go through.
+type ReturnPath struct {
+	Line int `json:"line"`
+	// the return or panic statement
+	Statement string `json:"statement"`
+	// the conditions of the branches enclosing the statement, from the outermost
+	Conditions []string `json:"conditions"`
+	// the name of the table case covering the path, like the "ko - task dao get next pending task" of the examples
+	Case string `json:"case"`
+}
+
+// pathsWalker walks the statements of a function in order, remembering the call that last set each variable,
+// so an `if err != nil` can be named after the call it checks.
+type pathsWalker struct {
+	src  []byte
+	fset *token.FileSet
+	// whether the function has results, a function without any can't fail by returning
+	hasResults bool
+	// whether the last result of the function is an error
+	returnsError bool
+
+	assignedBy map[string]*ast.CallExpr
+	paths      []*ReturnPath
+}
+
+// returnPaths lists the return and panic statements of fn, the returns of the func literals it declares excluded,
+// and the end of its body when a function without results can run to it.
+func returnPaths(src []byte, fset *token.FileSet, fn *ast.FuncDecl) []*ReturnPath {
+	walker := &pathsWalker{src: src, fset: fset, assignedBy: map[string]*ast.CallExpr{}, paths: []*ReturnPath{}}
+	if results := fieldTypes(fn.Type.Results); len(results) > 0 {
+		walker.hasResults = true
+		walker.returnsError = results[len(results)-1] == "error"
+	}
+
+	if fn.Body != nil {
+		walker.branch(fn.Body.List, nil, nil, "")
+
+		if len(fn.Body.List) == 0 || !terminates(fn.Body.List[len(fn.Body.List)-1]) {
+			walker.paths = append(walker.paths, &ReturnPath{
+				Line:       fset.Position(fn.Body.Rbrace).Line,
+				Statement:  "end of the function",
+				Conditions: []string{},
+				Case:       "ok",
+			})
+		}
+	}
+
+	// the cases are told apart by their name
+	count := map[string]int{}
+	for _, path := range walker.paths {
+		count[path.Case]++
+	}
+
+	for _, path := range walker.paths {
+		if count[path.Case] > 1 {
+			path.Case += fmt.Sprintf(" (line %d)", path.Line)
+		}
+	}
+
+	return walker.paths
+}
+
+// branch walks the statements run when cond holds. A branch ending with a failure is named after it, and its name
+// prefixes the cases of the other paths within it, like "task outdated and task finalizer exec" for the finalizer
+// failing in the branch returning ErrTaskOutdated.
+func (w *pathsWalker) branch(stmts []ast.Stmt, conditions []string, cond []ast.Expr, prefix string) {
+	nested := prefix
+	if len(stmts) > 0 {
+		if ret, ok := stmts[len(stmts)-1].(*ast.ReturnStmt); ok {
+			name := w.caseName(ret.Results, conditions, cond)
+			if w.returnedCall(ret.Results) != nil {
+				name = w.succeededName(conditions, cond)
+			}
+
+			if name != "ok" {
+				nested = and(prefix, name)
+			}
+		}
+	}
+
+	for i, stmt := range stmts {
+		if i == len(stmts)-1 {
+			w.stmt(stmt, conditions, cond, prefix)
+		} else {
+			w.stmt(stmt, conditions, cond, nested)
+		}
+	}
+}
+
+func (w *pathsWalker) stmt(stmt ast.Stmt, conditions []string, cond []ast.Expr, prefix string) {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		w.assign(stmt)
+	case *ast.ReturnStmt:
+		// a return giving back the error of a call, like return s.store.Get(ctx, id), either succeeds or fails with the call
+		if call := w.returnedCall(stmt.Results); call != nil {
+			name := w.succeededName(conditions, cond)
+			w.add(stmt, and(prefix, name), conditions)
+
+			if name == "ok" {
+				w.add(stmt, and(prefix, calleeWords(call)), conditions)
+			} else {
+				w.add(stmt, and(and(prefix, name), calleeWords(call)), conditions)
+			}
+
+			return
+		}
+
+		// a function ending with return err, or return x, err, either succeeds or fails with the call setting err
+		if len(conditions) == 0 && len(stmt.Results) > 0 && sentinel(stmt.Results) == nil {
+			if ident, ok := stmt.Results[len(stmt.Results)-1].(*ast.Ident); ok && ident.Name == "err" && w.assignedBy["err"] != nil {
+				w.add(stmt, and(prefix, "ok"), conditions)
+				w.add(stmt, and(prefix, calleeWords(w.assignedBy["err"])), conditions)
+
+				return
+			}
+		}
+
+		w.add(stmt, and(prefix, w.caseName(stmt.Results, conditions, cond)), conditions)
+	case *ast.ExprStmt:
+		if call, ok := stmt.X.(*ast.CallExpr); ok && types.ExprString(call.Fun) == "panic" {
+			name := w.conditionName(cond)
+			if name == "" {
+				name = w.caseName(call.Args, []string{"panic"}, cond)
+			}
+
+			w.add(stmt, and(prefix, name+" panics"), conditions)
+		}
+	case *ast.BlockStmt:
+		w.branch(stmt.List, conditions, cond, prefix)
+	case *ast.LabeledStmt:
+		w.stmt(stmt.Stmt, conditions, cond, prefix)
+	case *ast.IfStmt:
+		if stmt.Init != nil {
+			w.stmt(stmt.Init, conditions, cond, prefix)
+		}
+
+		condition := w.condition(stmt.Cond)
+		w.branch(stmt.Body.List, with(conditions, condition), []ast.Expr{stmt.Cond}, prefix)
+
+		if stmt.Else != nil {
+			w.stmt(stmt.Else, with(conditions, "not ("+condition+")"), nil, prefix)
+		}
+	case *ast.SwitchStmt:
+		if stmt.Init != nil {
+			w.stmt(stmt.Init, conditions, cond, prefix)
+		}
+
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CaseClause)
+
+			var (
+				condition   string
+				clauseConds []ast.Expr
+			)
+
+			switch {
+			case clause.List == nil:
+				condition = "default case of the switch"
+			case stmt.Tag == nil:
+				condition = w.conditions(clause.List)
+				clauseConds = clause.List
+			default:
+				condition = w.expr(stmt.Tag) + " is " + w.conditions(clause.List)
+			}
+
+			w.branch(clause.Body, with(conditions, condition), clauseConds, prefix)
+		}
+	case *ast.TypeSwitchStmt:
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CaseClause)
+
+			condition := "default case of the type switch"
+			if clause.List != nil {
+				condition = "type is " + w.conditions(clause.List)
+			}
+
+			w.branch(clause.Body, with(conditions, condition), nil, prefix)
+		}
+	case *ast.SelectStmt:
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CommClause)
+
+			condition := "default case of the select"
+			if clause.Comm != nil {
+				condition = "case " + w.expr(clause.Comm)
+			}
+
+			w.branch(clause.Body, with(conditions, condition), nil, prefix)
+		}
+	case *ast.ForStmt:
+		condition := "in the loop"
+		if stmt.Cond != nil {
+			condition = "in the loop while " + w.condition(stmt.Cond)
+		}
+
+		w.branch(stmt.Body.List, with(conditions, condition), nil, prefix)
+	case *ast.RangeStmt:
+		w.branch(stmt.Body.List, with(conditions, "in the loop over "+w.expr(stmt.X)), nil, prefix)
+	}
+}
+
+// terminates tells if a statement ends the function, like a return, a panic, an if whose branches both end it, or a
+// loop without condition nor break.
+func terminates(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		return ok && types.ExprString(call.Fun) == "panic"
+	case *ast.BlockStmt:
+		return len(stmt.List) > 0 && terminates(stmt.List[len(stmt.List)-1])
+	case *ast.LabeledStmt:
+		return terminates(stmt.Stmt)
+	case *ast.IfStmt:
+		return stmt.Else != nil && terminates(stmt.Body) && terminates(stmt.Else)
+	case *ast.ForStmt:
+		if stmt.Cond != nil {
+			return false
+		}
+
+		breaks := false
+		ast.Inspect(stmt.Body, func(node ast.Node) bool {
+			if branch, ok := node.(*ast.BranchStmt); ok && branch.Tok == token.BREAK {
+				breaks = true
+			}
+
+			return !breaks
+		})
+
+		return !breaks
+	}
+
+	return false
+}
+
+// assign remembers the call setting the variables of an assignment, like the call returning an err.
+func (w *pathsWalker) assign(stmt *ast.AssignStmt) {
+	call, ok := stmt.Rhs[len(stmt.Rhs)-1].(*ast.CallExpr)
+
+	for _, lhs := range stmt.Lhs {
+		if ident, isIdent := lhs.(*ast.Ident); isIdent {
+			if ok {
+				w.assignedBy[ident.Name] = call
+			} else {
+				delete(w.assignedBy, ident.Name)
+			}
+		}
+	}
+}
+
+func (w *pathsWalker) add(stmt ast.Stmt, name string, conditions []string) {
+	// the early returns of a function without results are other ways for it to succeed
+	if name != "ok" && (w.hasResults || strings.HasSuffix(name, " panics")) {
+		name = "ko - " + name
+	}
+
+	w.paths = append(w.paths, &ReturnPath{
+		Line:       w.fset.Position(stmt.Pos()).Line,
+		Statement:  w.expr(stmt),
+		Conditions: with(conditions),
+		Case:       name,
+	})
+}
+
+// caseName names the path of a return after, in order: the success of the function when it returns a nil error or
+// returns unconditionally without building an error, the sentinel error it returns, the condition it's under, the message
+// it returns or the constructor it calls.
+func (w *pathsWalker) caseName(results []ast.Expr, conditions []string, cond []ast.Expr) string {
+	err := sentinel(results)
+
+	switch {
+	case len(results) > 0 && types.ExprString(results[len(results)-1]) == "nil",
+		len(conditions) == 0 && err == nil && !w.returnsBuiltError(results):
+		return "ok"
+	case err != nil:
+		return words(strings.TrimPrefix(err.Name, "Err"))
+	}
+
+	if name := w.conditionName(cond); name != "" {
+		return name
+	}
+
+	for _, result := range results {
+		var message string
+
+		ast.Inspect(result, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.BasicLit:
+				if node.Kind == token.STRING && message == "" {
+					message, _ = strconv.Unquote(node.Value)
+				}
+			case *ast.FuncLit:
+				return false
+			}
+
+			return message == ""
+		})
+
+		// the message before its formatted values, like "scoring application" for "scoring application '%s': %w"
+		message, _, _ = strings.Cut(message, "%")
+		message, _, _ = strings.Cut(message, ":")
+		if message = strings.Trim(message, ` '"`); message != "" {
+			return message
+		}
+	}
+
+	for _, result := range results {
+		if call, ok := result.(*ast.CallExpr); ok {
+			if name := strings.TrimPrefix(calleeWords(call), "new "); name != "new" {
+				return name
+			}
+		}
+	}
+
+	if len(conditions) == 0 {
+		return "error"
+	}
+
+	return conditions[len(conditions)-1]
+}
+
+// succeededName names the path of a return giving back the error of a call when the call succeeds: the success of
+// the function when it returns unconditionally, the branch it's in otherwise.
+func (w *pathsWalker) succeededName(conditions []string, cond []ast.Expr) string {
+	if len(conditions) == 0 {
+		return "ok"
+	}
+
+	if name := w.conditionName(cond); name != "" {
+		return name
+	}
+
+	return conditions[len(conditions)-1]
+}
+
+// returnedCall returns the call a return gives the error of back, like the s.store.Get of return s.store.Get(ctx, id)
+// or the msg.Ack of return msg.Ack(). Nil for the other returns, and for the calls building an error.
+func (w *pathsWalker) returnedCall(results []ast.Expr) *ast.CallExpr {
+	if !w.returnsError || len(results) == 0 {
+		return nil
+	}
+
+	call, ok := ast.Unparen(results[len(results)-1]).(*ast.CallExpr)
+	if !ok || errorConstructor(call) {
+		return nil
+	}
+
+	return call
+}
+
+// returnsBuiltError tells if a return of an error function gives back an error it builds, like fmt.Errorf(...) or
+// &ValidationError{...}, rather than a variable that can be nil.
+func (w *pathsWalker) returnsBuiltError(results []ast.Expr) bool {
+	if !w.returnsError || len(results) == 0 {
+		return false
+	}
+
+	switch result := ast.Unparen(results[len(results)-1]).(type) {
+	case *ast.CallExpr:
+		return errorConstructor(result)
+	case *ast.CompositeLit:
+		return true
+	case *ast.UnaryExpr:
+		_, ok := result.X.(*ast.CompositeLit)
+		return ok
+	}
+
+	return false
+}
+
+// the packages building errors
+var errorPackages = map[string]bool{
+	"errors":  true,
+	"fmt":     true,
+	"status":  true,
+	"xerrors": true,
+}
+
+// errorConstructor tells if a call builds an error rather than giving back the one of what it calls: a call of a
+// package building errors, like fmt.Errorf, errors.Wrap or status.Error, a function named after an error, like
+// newValidationError, or a call wrapping a sentinel error.
+func errorConstructor(call *ast.CallExpr) bool {
+	fun := call.Fun
+	if selector, ok := fun.(*ast.SelectorExpr); ok {
+		if pkg, ok := selector.X.(*ast.Ident); ok && errorPackages[pkg.Name] {
+			return true
+		}
+
+		fun = selector.Sel
+	}
+
+	if ident, ok := fun.(*ast.Ident); ok && strings.Contains(ident.Name, "Err") {
+		return true
+	}
+
+	return sentinel([]ast.Expr{call}) != nil
+}
+
+// conditionName names a branch after its conditions: the sentinel error of an errors.Is, the call an err comes from,
+// or the boolean it tests. Empty for the other conditions.
+func (w *pathsWalker) conditionName(conds []ast.Expr) string {
+	var names []string
+
+	for _, cond := range conds {
+		name := w.exprName(cond)
+		if name == "" {
+			return ""
+		}
+
+		names = append(names, name)
+	}
+
+	return strings.Join(names, " or ")
+}
+
+func (w *pathsWalker) exprName(cond ast.Expr) string {
+	switch cond := cond.(type) {
+	case *ast.ParenExpr:
+		return w.exprName(cond.X)
+	case *ast.CallExpr:
+		if fun := types.ExprString(cond.Fun); (fun == "errors.Is" || fun == "errors.As") && len(cond.Args) == 2 {
+			if err := sentinel(cond.Args[1:]); err != nil {
+				return words(strings.TrimPrefix(err.Name, "Err"))
+			}
+		}
+
+		return calleeWords(cond)
+	case *ast.BinaryExpr:
+		switch cond.Op {
+		case token.LAND:
+			// the last check is the most specific, like the Timeout() of errors.As(err, &netErr) && netErr.Timeout()
+			if name := w.exprName(cond.Y); name != "" {
+				return name
+			}
+
+			return w.exprName(cond.X)
+		case token.NEQ:
+			if ident, ok := cond.X.(*ast.Ident); ok && types.ExprString(cond.Y) == "nil" && w.assignedBy[ident.Name] != nil {
+				return calleeWords(w.assignedBy[ident.Name])
+			}
+		}
+	case *ast.Ident:
+		return words(cond.Name)
+	case *ast.UnaryExpr:
+		if name := w.exprName(cond.X); name != "" && cond.Op == token.NOT {
+			return "not " + name
+		}
+	}
+
+	return ""
+}
+
+// condition describes a condition, telling which call set the variables it tests.
+func (w *pathsWalker) condition(cond ast.Expr) string {
+	description := w.expr(cond)
+
+	var from []string
+	seen := map[string]bool{}
+
+	ast.Inspect(cond, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && !seen[ident.Name] {
+			seen[ident.Name] = true
+			if call := w.assignedBy[ident.Name]; call != nil {
+				from = append(from, ident.Name+" from "+w.expr(call))
+			}
+		}
+
+		return true
+	})
+
+	if len(from) > 0 {
+		description += " (" + strings.Join(from, ", ") + ")"
+	}
+
+	return description
+}
+
+// expr returns the code of a node on a single line.
+func (w *pathsWalker) expr(node ast.Node) string {
+	code := strings.Join(strings.Fields(sourceOf(w.src, w.fset, node, nil)), " ")
+
+	return strings.NewReplacer("( ", "(", ", )", ")", "{ ", "{", ", }", "}").Replace(code)
+}
+
+// conditions describes the conditions of a case clause, any of them being enough.
+func (w *pathsWalker) conditions(list []ast.Expr) string {
+	var descriptions []string
+	for _, expr := range list {
+		descriptions = append(descriptions, w.condition(expr))
+	}
+
+	return strings.Join(descriptions, " or ")
+}
+
+// sentinel returns the cause a return gives back when it's a sentinel error, like ErrTaskOutdated in
+// errors.Wrap(ErrTaskOutdated, ErrGetNextPendingTaskFailed) or in fmt.Errorf("%s: %w", id, ErrTaskOutdated).
+// The errors only wrapping another one aren't causes.
+func sentinel(results []ast.Expr) *ast.Ident {
+	for _, result := range results {
+		for {
+			call, ok := result.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				break
+			}
+
+			if types.ExprString(call.Fun) == "fmt.Errorf" {
+				result = call.Args[len(call.Args)-1]
+				continue
+			}
+
+			result = call.Args[0]
+		}
+
+		var ident *ast.Ident
+		switch result := result.(type) {
+		case *ast.Ident:
+			ident = result
+		case *ast.SelectorExpr:
+			ident = result.Sel
+		}
+
+		if ident != nil && strings.HasPrefix(ident.Name, "Err") {
+			return ident
+		}
+	}
+
+	return nil
+}
+
+// calleeWords names a call after the exported fields and method it goes through, like deps.TaskDAO.GetNextPendingTask
+// for "task dao get next pending task".
+func calleeWords(call *ast.CallExpr) string {
+	var names []string
+
+	for fun := call.Fun; ; {
+		switch expr := fun.(type) {
+		case *ast.SelectorExpr:
+			names = append([]string{expr.Sel.Name}, names...)
+			fun = expr.X
+			continue
+		case *ast.Ident:
+			names = append([]string{expr.Name}, names...)
+		}
+
+		break
+	}
+
+	// the receiver, the local variables and the packages don't tell what the call does
+	for len(names) > 1 && !ast.IsExported(names[0]) {
+		names = names[1:]
+	}
+
+	if len(names) > 2 {
+		names = names[len(names)-2:]
+	}
+
+	return words(strings.Join(names, ""))
+}
+
+// words splits a camel case name into lower case words, keeping the acronyms together: TaskDAO gives "task dao".
+func words(name string) string {
+	var (
+		runes = []rune(name)
+		found []string
+		start int
+	)
+
+	for i := 1; i < len(runes); i++ {
+		lowerBefore := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+		// the last upper case letter of an acronym starts the next word, like the G of DAOGet
+		acronymEnd := unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+		if unicode.IsUpper(runes[i]) && (lowerBefore || acronymEnd) || runes[i] == '_' {
+			found = append(found, string(runes[start:i]))
+			start = i
+		}
+
+		if runes[i] == '_' {
+			start = i + 1
+		}
+	}
+
+	found = append(found, string(runes[start:]))
+
+	return strings.ToLower(strings.Join(strings.Fields(strings.Join(found, " ")), " "))
+}
+
+// and joins the names of nested branches.
+func and(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + " and " + name
+}
+
+func with(conditions []string, more ...string) []string {
+	return append(append([]string{}, conditions...), more...)
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestReturnPaths(t *testing.T) {
+	flagTestReturnPaths := []struct {
+		name string
+
+		src string
+
+		expectedCases []string
+	}{
+		{
+			name: "ok - returned call giving back its error",
+
+			src: `package store
+
+func (s *Service) Get(ctx context.Context, id string) (*Item, error) {
+	return s.store.Get(ctx, id)
+}
+`,
+
+			expectedCases: []string{"ok", "ko - get"},
+		},
+		{
+			name: "ok - error of the last call returned",
+
+			src: `package store
+
+func (s *Service) Save(ctx context.Context, item *Item) (int64, error) {
+	id, err := s.store.Insert(ctx, item)
+	return id, err
+}
+`,
+
+			expectedCases: []string{"ok", "ko - insert"},
+		},
+		{
+			name: "ok - conditional returned call",
+
+			src: `package consumers
+
+func (c *Consumer) Consume(msg Message) error {
+	payload, err := decode(msg.Body)
+	if err != nil {
+		return msg.Nack(false)
+	}
+
+	return c.handle(payload)
+}
+`,
+
+			expectedCases: []string{"ko - decode", "ko - decode and nack", "ok", "ko - handle"},
+		},
+		{
+			name: "ok - unconditional error built",
+
+			src: `package store
+
+func (s *Service) Delete(ctx context.Context, id string) (*Item, error) {
+	return nil, fmt.Errorf("not implemented: %s", id)
+}
+`,
+
+			expectedCases: []string{"ko - not implemented"},
+		},
+		{
+			name: "ok - unconditional sentinel error",
+
+			src: `package store
+
+func (s *Service) Update(ctx context.Context, id string) error {
+	return errors.Wrap(ErrReadOnly, "update")
+}
+`,
+
+			expectedCases: []string{"ko - read only"},
+		},
+		{
+			name: "ok - branches",
+
+			src: `package workers
+
+func (w *Worker) Run(ctx context.Context) (*Task, error) {
+	task, err := w.TaskDAO.GetNext(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "get next task")
+	}
+
+	if errors.Is(err, ErrTaskOutdated) {
+		return nil, ErrTaskOutdated
+	}
+
+	if task == nil {
+		panic("no task")
+	}
+
+	return task, nil
+}
+`,
+
+			expectedCases: []string{"ko - task dao get next", "ko - task outdated", "ko - no task panics", "ok"},
+		},
+		{
+			name: "ok - no error returned",
+
+			src: `package utils
+
+func Max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+`,
+
+			expectedCases: []string{"ko - a > b", "ok"},
+		},
+		{
+			name: "ok - function without results running to its end",
+
+			src: `package notifiers
+
+func (s *S) Notify(a int) {
+	if a > 10 {
+		return
+	}
+	if a < 0 {
+		return
+	}
+	s.Sender.Send("x")
+}
+`,
+
+			expectedCases: []string{"a > 10", "a < 0", "ok"},
+		},
+		{
+			name: "ok - function without results ending with a panic",
+
+			src: `package notifiers
+
+func (s *S) Notify(a int) {
+	if a > 10 {
+		return
+	}
+	panic("too low")
+}
+`,
+
+			expectedCases: []string{"a > 10", "ko - too low panics"},
+		},
+		{
+			name: "ok - func literal returns left out",
+
+			src: `package utils
+
+func Apply(values []int) []int {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	return values
+}
+`,
+
+			expectedCases: []string{"ok"},
+		},
+		{
+			name: "ok - cases told apart by their line",
+
+			src: `package utils
+
+func Key(jq string) (string, error) {
+	if jq == "" {
+		return "", nil
+	}
+
+	return jq, nil
+}
+`,
+
+			expectedCases: []string{"ok (line 5)", "ok (line 8)"},
+		},
+	}
+
+	for _, tt := range flagTestReturnPaths {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fset := token.NewFileSet()
+
+			file, err := parser.ParseFile(fset, "code.go", tt.src, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cases := []string{}
+			for _, path := range returnPaths([]byte(tt.src), fset, file.Decls[0].(*ast.FuncDecl)) {
+				cases = append(cases, path.Case)
+			}
+
+			assert.Equal(tt.expectedCases, cases)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// TypeDefinition is a struct of the module or of its dependencies that a function uses, so the model
+// doesn't have to guess its fields.
+type TypeDefinition struct {
+	// the name qualified by its package, like entities.Task
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Code string `json:"code"`
+}
+
+// loadedFile is the typed syntax of a file, loaded with the module it belongs to.
+type loadedFile struct {
+	pkg  *packages.Package
+	file *ast.File
+}
+
+// loadFile loads the package of the go file at path with go/packages, type checking it against the module and
+// the dependencies of its go.mod. Nil when the package can't be loaded, a broken module not preventing the analysis.
+func loadFile(path string) *loadedFile {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:  filepath.Dir(absPath),
+	}, ".")
+	if err != nil {
+		return nil
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			if filepath.Clean(pkg.Fset.File(file.Pos()).Name()) == absPath {
+				return &loadedFile{pkg: pkg, file: file}
+			}
+		}
+	}
+
+	return nil
+}
+
+// usedStructs returns the definitions of the structs the function named name uses, in its signature and in its body,
+// declared outside of its file and of the standard library, like the applications.CreateApplicationCommentParams a
+// handler gets or the entities.Task a worker returns.
+func (l *loadedFile) usedStructs(name string) []*TypeDefinition {
+	var fn *ast.FuncDecl
+	for _, decl := range l.file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && functionName(decl) == name {
+			fn = decl
+		}
+	}
+
+	if fn == nil {
+		return []*TypeDefinition{}
+	}
+
+	var (
+		seen        = map[*types.TypeName]bool{}
+		definitions = []*TypeDefinition{}
+	)
+
+	add := func(t types.Type) {
+		named := namedStruct(t)
+		if named == nil {
+			return
+		}
+
+		obj := named.Obj()
+		if seen[obj] || obj.Pkg() == nil || standardLibrary(obj.Pkg().Path()) {
+			return
+		}
+
+		seen[obj] = true
+
+		// the structs of the file are in its code already
+		if l.pkg.Fset.File(obj.Pos()) == l.pkg.Fset.File(l.file.Pos()) {
+			return
+		}
+
+		definitions = append(definitions, &TypeDefinition{
+			Name: qualifiedName(obj, l.pkg.Types),
+			Path: obj.Pkg().Path(),
+			Code: l.structCode(named),
+		})
+	}
+
+	for _, list := range []*ast.FieldList{fn.Recv, fn.Type.Params, fn.Type.Results} {
+		if list != nil {
+			for _, field := range list.List {
+				add(l.pkg.TypesInfo.TypeOf(field.Type))
+			}
+		}
+	}
+
+	// the structs it builds, the ones whose fields it reads or writes, and the types of those fields
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CompositeLit:
+			add(l.pkg.TypesInfo.TypeOf(node))
+		case *ast.SelectorExpr:
+			if selection := l.pkg.TypesInfo.Selections[node]; selection != nil && selection.Kind() == types.FieldVal {
+				add(selection.Recv())
+				add(selection.Obj().Type())
+			}
+		}
+
+		return true
+	})
+
+	return definitions
+}
+
+// structCode writes a struct as it's declared, with its fields, their tags and whether they're pointers.
+// The unexported fields of the structs of other packages are left out.
+func (l *loadedFile) structCode(named *types.Named) string {
+	obj := named.Obj()
+
+	// as declared in its package
+	qualifier := func(pkg *types.Package) string {
+		if pkg == obj.Pkg() {
+			return ""
+		}
+
+		return pkg.Name()
+	}
+
+	structType := named.Underlying().(*types.Struct)
+
+	var code strings.Builder
+
+	fmt.Fprintf(&code, "// %s\ntype %s struct {\n", obj.Pkg().Path(), obj.Name())
+
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		// the code to test can't set them
+		if !field.Exported() && obj.Pkg() != l.pkg.Types {
+			continue
+		}
+
+		fieldType := types.TypeString(field.Type(), qualifier)
+		if field.Embedded() {
+			fmt.Fprintf(&code, "\t%s", fieldType)
+		} else {
+			fmt.Fprintf(&code, "\t%s %s", field.Name(), fieldType)
+		}
+
+		if tag := structType.Tag(i); tag != "" {
+			fmt.Fprintf(&code, " `%s`", tag)
+		}
+
+		code.WriteString("\n")
+	}
+
+	code.WriteString("}")
+
+	formatted, err := format.Source([]byte(code.String()))
+	if err != nil {
+		return code.String()
+	}
+
+	return string(formatted)
+}
+
+// namedStruct returns the named struct a type is, points to or holds, like the Task of []*entities.Task.
+func namedStruct(t types.Type) *types.Named {
+	for {
+		switch typ := t.(type) {
+		case *types.Pointer:
+			t = typ.Elem()
+		case *types.Slice:
+			t = typ.Elem()
+		case *types.Array:
+			t = typ.Elem()
+		case *types.Map:
+			t = typ.Elem()
+		case *types.Alias:
+			t = types.Unalias(typ)
+		case *types.Named:
+			if _, ok := typ.Underlying().(*types.Struct); ok {
+				return typ
+			}
+
+			return nil
+		default:
+			return nil
+		}
+	}
+}
+
+func qualifiedName(obj *types.TypeName, from *types.Package) string {
+	if obj.Pkg() == from {
+		return obj.Name()
+	}
+
+	return obj.Pkg().Name() + "." + obj.Name()
+}
+
+// standardLibrary tells if an import path is one of the standard library, their first element having no dot.
+func standardLibrary(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+
+	return !strings.Contains(first, ".")
+}
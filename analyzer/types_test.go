@@ -0,0 +1,122 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestTypes(t *testing.T) {
+	flagTestTypes := []struct {
+		name string
+
+		files map[string]string
+
+		expectedTypes map[string][]*TypeDefinition
+	}{
+		{
+			name: "ok",
+
+			files: map[string]string{
+				"go.mod": "module example.com/m\n\ngo 1.26.0\n",
+				"entities/task.go": `package entities
+
+import "time"
+
+type Task struct {
+	ID        string ` + "`json:\"id\"`" + `
+	Owner     *User
+	CreatedAt time.Time
+	state     int
+}
+
+type User struct {
+	Name string
+}
+`,
+				"workers/code.go": `package workers
+
+import (
+	"context"
+	"time"
+
+	"example.com/m/entities"
+)
+
+type Options struct {
+	Timeout time.Duration
+}
+
+func Run(ctx context.Context, options Options) ([]*entities.Task, error) {
+	task := &entities.Task{ID: "id"}
+
+	return []*entities.Task{task}, nil
+}
+
+func Owner(task entities.Task) string {
+	return task.Owner.Name
+}
+`,
+			},
+
+			expectedTypes: map[string][]*TypeDefinition{
+				"Run": {
+					{
+						Name: "entities.Task",
+						Path: "example.com/m/entities",
+						Code: "// example.com/m/entities\ntype Task struct {\n\tID        string `json:\"id\"`\n\tOwner     *User\n\tCreatedAt time.Time\n}",
+					},
+				},
+				"Owner": {
+					{
+						Name: "entities.Task",
+						Path: "example.com/m/entities",
+						Code: "// example.com/m/entities\ntype Task struct {\n\tID        string `json:\"id\"`\n\tOwner     *User\n\tCreatedAt time.Time\n}",
+					},
+					{
+						Name: "entities.User",
+						Path: "example.com/m/entities",
+						Code: "// example.com/m/entities\ntype User struct {\n\tName string\n}",
+					},
+				},
+			},
+		},
+		{
+			name: "ok - not in a module",
+
+			files: map[string]string{
+				"workers/code.go": `package workers
+
+func Run(task Task) error {
+	return nil
+}
+`,
+			},
+
+			expectedTypes: map[string][]*TypeDefinition{
+				"Run": {},
+			},
+		},
+	}
+
+	for _, tt := range flagTestTypes {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := writeFiles(t, tt.files)
+
+			res, err := Functions(filepath.Join(dir, "workers", "code.go"))
+			assert.NoError(err)
+
+			typeDefinitions := map[string][]*TypeDefinition{}
+			for _, function := range res {
+				typeDefinitions[function.Name] = function.Types
+			}
+
+			assert.Equal(tt.expectedTypes, typeDefinitions)
+		})
+	}
+}
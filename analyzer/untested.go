@@ -0,0 +1,60 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// Untested returns the exported functions and methods of the go file at path that no prefixed function of the test
+// file at testPath covers. A method is covered by a PrefixMethod, PrefixTypeMethod or PrefixType_Method function, or by
+// a PrefixType function when it's the only method of its type to test, like the Handle method of the handlers.
+func Untested(path, testPath, prefix string) ([]*Function, error) {
+	functions, err := Functions(path)
+	if err != nil {
+		return nil, err
+	}
+
+	testFile, err := parser.ParseFile(token.NewFileSet(), testPath, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	tested := map[string]bool{}
+	for _, decl := range testFile.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, prefix) {
+			name := strings.TrimPrefix(fn.Name.Name, prefix)
+			// TestFetch_error tests Fetch too
+			before, _, _ := strings.Cut(name, "_")
+
+			tested[name] = true
+			tested[before] = true
+		}
+	}
+
+	methods := map[string]int{}
+	for _, function := range functions {
+		if typeName, _, isMethod := strings.Cut(function.Name, "."); isMethod {
+			methods[typeName]++
+		}
+	}
+
+	untested := []*Function{}
+
+	for _, function := range functions {
+		typeName, method, isMethod := strings.Cut(function.Name, ".")
+
+		covered := tested[function.Name]
+		if isMethod {
+			covered = tested[method] || tested[typeName+method] || tested[typeName+"_"+method] ||
+				(methods[typeName] == 1 && tested[typeName])
+		}
+
+		if !covered {
+			untested = append(untested, function)
+		}
+	}
+
+	return untested, nil
+}
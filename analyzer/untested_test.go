@@ -0,0 +1,131 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestUntested(t *testing.T) {
+	const src = `package services
+
+type Service struct{}
+
+func (s *Service) Get() error {
+	return nil
+}
+
+func (s *Service) List() error {
+	return nil
+}
+
+func (s *Service) Delete() error {
+	return nil
+}
+
+type Handler struct{}
+
+func (h *Handler) Handle() error {
+	return nil
+}
+
+func Max(a, b int) int {
+	return max(a, b)
+}
+`
+
+	flagTestUntested := []struct {
+		name string
+
+		testSrc string
+		prefix  string
+
+		expectedNames []string
+		expectErr     bool
+	}{
+		{
+			name: "ok - nothing tested",
+
+			testSrc: "package services\n",
+			prefix:  "Test",
+
+			expectedNames: []string{"Service.Get", "Service.List", "Service.Delete", "Handler.Handle", "Max"},
+		},
+		{
+			name: "ok - every naming of the tests",
+
+			testSrc: `package services
+
+func TestGet(t *testing.T) {}
+
+func TestServiceList(t *testing.T) {}
+
+func TestService_Delete(t *testing.T) {}
+
+func TestHandler(t *testing.T) {}
+
+func TestMax_error(t *testing.T) {}
+`,
+			prefix: "Test",
+
+			expectedNames: []string{},
+		},
+		{
+			name: "ok - type test of a type with several methods",
+
+			testSrc: `package services
+
+func TestService(t *testing.T) {}
+`,
+			prefix: "Test",
+
+			expectedNames: []string{"Service.Get", "Service.List", "Service.Delete", "Handler.Handle", "Max"},
+		},
+		{
+			name: "ok - other prefix",
+
+			testSrc: `package services
+
+func TestMax(t *testing.T) {}
+
+func FuzzGet(f *testing.F) {}
+`,
+			prefix: "Fuzz",
+
+			expectedNames: []string{"Service.List", "Service.Delete", "Handler.Handle", "Max"},
+		},
+		{
+			name: "ko - invalid test file",
+
+			testSrc: "package services\n\nfunc (",
+			prefix:  "Test",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range flagTestUntested {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			dir := writeFiles(t, map[string]string{"code.go": src, "code_test.go": tt.testSrc})
+
+			res, err := Untested(filepath.Join(dir, "code.go"), filepath.Join(dir, "code_test.go"), tt.prefix)
+			assert.Equal(tt.expectErr, err != nil)
+
+			if tt.expectErr {
+				return
+			}
+
+			names := []string{}
+			for _, function := range res {
+				names = append(names, function.Name)
+			}
+
+			assert.Equal(tt.expectedNames, names)
+		})
+	}
+}
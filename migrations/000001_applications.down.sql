@@ -0,0 +1,2 @@
+DROP SCHEMA IF EXISTS applications CASCADE;
+DROP TYPE IF EXISTS comment_kind;
@@ -0,0 +1,66 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE SCHEMA IF NOT EXISTS applications;
+
+CREATE TABLE applications.applications (
+    id SERIAL PRIMARY KEY,
+    external_id TEXT,
+
+    job_id TEXT NOT NULL,
+    organization_name TEXT,
+
+    campaign_id TEXT NOT NULL,
+    candidate_id CHARACTER VARYING(36),
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    last_interaction_date timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE applications.answers (
+    id BIGINT PRIMARY KEY,
+    application_id BIGINT NOT NULL,
+    question_label TEXT NOT NULL,
+    answer TEXT NOT NULL,
+    CONSTRAINT answers FOREIGN KEY (application_id) REFERENCES applications.applications (id)
+);
+
+CREATE TYPE comment_kind AS ENUM (
+    'COMMENT',
+    'INTERVIEW_CANCELLED'
+);
+
+CREATE TABLE applications.comments (
+    id BIGSERIAL PRIMARY KEY,
+    application_id BIGINT NOT NULL,
+    content TEXT NOT NULL,
+    user_entity_id CHARACTER VARYING(36) NOT NULL,
+    kind comment_kind NOT NULL DEFAULT 'COMMENT',
+    "is_bulk_action" BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT comments FOREIGN KEY (application_id) REFERENCES applications.applications (id),
+    CONSTRAINT comments_unique_content UNIQUE (application_id, user_entity_id, content)
+);
+
+CREATE TABLE applications.statuses (
+    id BIGINT PRIMARY KEY,
+    external_id uuid DEFAULT uuid_generate_v4() NOT NULL UNIQUE,
+    label TEXT NOT NULL
+);
+
+CREATE TABLE applications.application_statuses (
+    id BIGINT PRIMARY KEY,
+    external_id TEXT,
+
+    application_id BIGINT NOT NULL,
+    status_id BIGINT NOT NULL,
+    user_entity_id CHARACTER VARYING(36) NOT NULL,
+
+    "is_bulk_action" BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT application_fk FOREIGN KEY (application_id) REFERENCES applications.applications (id),
+    CONSTRAINT status_fk FOREIGN KEY (status_id) REFERENCES applications.statuses (id) ON DELETE SET NULL
+);
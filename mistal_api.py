@@ -1,8 +1,17 @@
+import argparse
 import asyncio
-import sys
+import functools
+import glob
+import io
+import itertools
+import json
 import os
 import re
+import shutil
+import subprocess
+import tempfile
 
+import yaml
 from openai import OpenAI
 from transformers import AutoTokenizer
 
@@ -79,80 +88,771 @@ def mistral_token_count(message):
     return token_count
 
 
-def generate_test(codeType, code_to_test, target):
-    code_example_path = f"./pkg/{codeType}/code.go"
-    test_example_path = f"./pkg/{codeType}/test.go"
-
-    with open(code_example_path, encoding='UTF-8') as code_example_f:
-        with open(test_example_path, encoding='UTF-8') as test_example_f:
-            with open(code_to_test, encoding='UTF-8') as code_to_test_f:
-                with open(target, 'w', encoding='UTF-8') as target_f:
-                    print(">> starting generation for " + target)
-                    system_instruct = f"""
-                        You are a professional programmer and expert in the golang language.
-                        I'm going to give you an example of code and associated tests.
-
-                        example of code:
-                        ```go
-                        {code_example_f.read()}
-                        ```
-
-                        example of tests for the code:
-                        ```go
-                        {test_example_f.read()}
-                        ```
-                    """
-                    message = f"""
-                        Generate me test for this code.
-                        It's very important to me that you copy the iteration over the flagTest array.
-
-                        ```go
-                        {code_to_test_f.read()}
-                        ```
-                    """
-                    # call_chatgpt(system_instruct, message, target_f, 7800 - mistral_token_count(system_instruct + message))
-                    hg_api_mistral_inference(system_instruct, message, target_f, 7800 - mistral_token_count(system_instruct + message))
+def read_embedded_files(code_path, code):
+    """
+    Resolve the //go:embed directives of a go file, relative to its folder like the go tool does,
+    and return the (path, content) of every embedded .sql file, the other assets not telling the model anything.
+    """
+    directory = os.path.dirname(code_path)
+    embedded = []
+
+    for patterns in re.findall(r'^//go:embed (.+)$', code, flags=re.MULTILINE):
+        for pattern in patterns.split():
+            # all: embeds the files starting with . or _ of the folders too
+            pattern, with_hidden = re.subn(r'^all:', '', pattern.strip('"`'))
+            for path in sorted(glob.glob(os.path.join(directory, pattern))):
+                # embedding a folder embeds all the files within it
+                paths = [path] if os.path.isfile(path) else sorted(
+                    os.path.join(root, name) for root, _, names in os.walk(path) for name in names
+                    if with_hidden or not re.search(r'(^|/)[._]', os.path.relpath(os.path.join(root, name), path))
+                )
+
+                for embedded_path in paths:
+                    if embedded_path.endswith(".sql"):
+                        with open(embedded_path, encoding='UTF-8', errors='replace') as embedded_f:
+                            embedded.append((os.path.relpath(embedded_path, directory), embedded_f.read()))
+
+    return embedded
+
+
+def read_code(code_path, code=None):
+    """
+    Read a go file and append the files it embeds, so the model can see the queries it runs.
+    The code can be given when only a part of the file is wanted, the embedded files are still found from its path.
+    """
+    if code is None:
+        with open(code_path, encoding='UTF-8') as code_f:
+            code = code_f.read()
+
+    content = f"```go\n{code}\n```"
+    for embedded_path, embedded_code in read_embedded_files(code_path, code):
+        content += f"\n\nembedded file {embedded_path}:\n```sql\n{embedded_code}\n```"
+
+    return content
+
+
+@functools.lru_cache(maxsize=None)
+def read_manifest(codeType):
+    """
+    Read the manifest of an examples folder: the conventions its tests follow, the imports they require, where the mocks live,
+    the special instructions and helper packages given to the model, and the examples only used when asked for.
+    """
+    manifest = {
+        "conventions": [],
+        "required_imports": [],
+        "mocks_package": None,
+        "instructions": "",
+        "helpers": [],
+        "opt_in": [],
+    }
+
+    manifest_path = f"./pkg/{codeType}/manifest.yaml"
+    if os.path.exists(manifest_path):
+        with open(manifest_path, encoding='UTF-8') as manifest_f:
+            manifest.update(yaml.safe_load(manifest_f) or {})
+
+    return manifest
+
+
+def go_module_dir(directory):
+    """
+    Return the folder of the go module holding a folder, the one of its go.mod. None when the folder isn't in a module.
+    """
+    module_dir = os.path.abspath(directory)
+    while not os.path.exists(os.path.join(module_dir, "go.mod")):
+        if os.path.dirname(module_dir) == module_dir:
+            return None
+        module_dir = os.path.dirname(module_dir)
+
+    return module_dir
+
+
+def go_package_path(directory):
+    """
+    Return the module path of the go module holding a folder, and the import path of the package in it.
+    None for both when the folder isn't in a module.
+    """
+    module_dir = go_module_dir(directory)
+    if module_dir is None:
+        return None, None
+
+    with open(os.path.join(module_dir, "go.mod"), encoding='UTF-8') as go_mod_f:
+        module = re.search(r'^module\s+(\S+)', go_mod_f.read(), flags=re.MULTILINE)
+    if module is None:
+        return None, None
+
+    relative_dir = os.path.relpath(os.path.abspath(directory), module_dir)
+    package = module.group(1) if relative_dir == "." else f"{module.group(1)}/{relative_dir}"
+    return module.group(1), package
+
+
+def expand_manifest_path(manifest_path, code_to_test):
+    """
+    Replace the {module} and {package} placeholders of a manifest path by the ones of the code to test.
+    """
+    module, package = go_package_path(os.path.dirname(code_to_test))
+    if module is None:
+        # keep the shape of the path, the model will guess the module
+        module, package = "<module>", f"<module>/{os.path.basename(os.path.dirname(os.path.abspath(code_to_test)))}"
+
+    return manifest_path.format(module=module, package=package)
+
+
+def example_manifest(manifest, code_example_path, test_example_path):
+    """
+    Narrow the manifest to the example picked: the imports it requires are the ones the example imports, like the golden
+    example asserting through its helper rather than testify, and the helpers are the ones of every example or of its variant.
+    """
+    with open(test_example_path, encoding='UTF-8') as test_example_f:
+        example = test_example_f.read()
+    variant = os.path.basename(code_example_path).removesuffix("code.go")
+
+    return {
+        **manifest,
+        # the paths having a placeholder can't be told apart in the example
+        "required_imports": [imp for imp in manifest["required_imports"] if "{" in imp or imp.split()[-1] in example],
+        "helpers": [helper for helper in manifest["helpers"] if helper.get("variant", variant) == variant],
+    }
+
+
+def install_helpers(manifest, code_to_test, test_code):
+    """
+    Copy the helpers of the manifest into the module of the code to test when it doesn't have them yet, so the generated
+    tests using them build: the helper packages in their own folder, and the helper test files, like the golden one, in the
+    package of the tests. The ones already there are reused as they are.
+    """
+    module_dir = go_module_dir(os.path.dirname(code_to_test))
+    if module_dir is None:
+        return
+
+    module, package = go_package_path(os.path.dirname(code_to_test))
+    for helper in manifest["helpers"]:
+        # the examples module has them already
+        if go_module_dir(os.path.dirname(helper["path"])) == module_dir:
+            continue
+
+        with open(helper["path"], encoding='UTF-8') as helper_f:
+            helper_code = helper_f.read()
+
+        helper_package = expand_manifest_path(helper["package"], code_to_test)
+        helper_dir = os.path.join(module_dir, helper_package.removeprefix(module).lstrip("/"))
+        if helper_package == package:
+            # declared once per package, the tests of another file, or the generated ones, may have them already
+            declarations = set(re.findall(r'^(?:func|var) (\w+)', helper_code, flags=re.MULTILINE))
+            for test_path in glob.glob(os.path.join(helper_dir, "*_test.go")):
+                with open(test_path, encoding='UTF-8') as test_f:
+                    if declarations & set(re.findall(r'^(?:func|var) (\w+)', test_f.read(), flags=re.MULTILINE)):
+                        break
+            else:
+                test_package = re.search(r'^package (\w+)', test_code, flags=re.MULTILINE)
+                if test_package is None:
+                    continue
+
+                helper_code = re.sub(r'^package \w+', f"package {test_package.group(1)}", helper_code, count=1, flags=re.MULTILINE)
+                with open(os.path.join(helper_dir, os.path.basename(helper["path"])), 'w', encoding='UTF-8') as helper_f:
+                    helper_f.write(helper_code)
+                print(f">> copied the {os.path.basename(helper['path'])} helper to {helper_dir}")
+            continue
+
+        if glob.glob(os.path.join(helper_dir, "*.go")):
+            continue
+
+        os.makedirs(helper_dir, exist_ok=True)
+        shutil.copy(helper["path"], helper_dir)
+        print(f">> copied the {helper_package} helper package to {helper_dir}, run go mod tidy if the module lacks its dependencies")
+
+
+def manifest_instructions(manifest, code_to_test):
+    """
+    Turn the manifest of the examples into instructions for the model.
+    """
+    lines = [f"- {convention['rule']}" for convention in manifest["conventions"]]
+    lines += [f"- import {expand_manifest_path(imp, code_to_test)}" for imp in manifest["required_imports"]]
+    if manifest["mocks_package"]:
+        lines.append(
+            f"- the mocks generated by mockery are in the {expand_manifest_path(manifest['mocks_package'], code_to_test)} package, "
+            "don't declare them in the test file"
+        )
+
+    instructions = ""
+    if lines:
+        instructions += "It's very important to me that the tests follow these conventions:\n" + "\n".join(lines)
+    if manifest["instructions"]:
+        instructions += "\n" + manifest["instructions"].strip()
+
+    return instructions
+
+
+def validate_test(manifest, code_to_test, test_code):
+    """
+    Check generated tests against the manifest of their examples, and return the conventions they break.
+    """
+    problems = [
+        f"doesn't follow the convention: {convention['rule']}"
+        for convention in manifest["conventions"]
+        if not re.search(convention["pattern"], test_code)
+    ]
+
+    imports = set()
+    for block, single in re.findall(r'^import (?:\(([^)]*)\)|(.+))$', test_code, flags=re.MULTILINE):
+        imports.update(line.strip() for line in (block or single).splitlines())
+
+    def is_imported(imp):
+        # out of a module, only the end of the path can be checked
+        if "<module>" in imp:
+            return any(line.endswith(imp.split("<module>", 1)[1]) for line in imports)
+        return imp in imports
+
+    for imp in manifest["required_imports"]:
+        imp = expand_manifest_path(imp, code_to_test)
+        if not is_imported(imp):
+            problems.append(f"doesn't import {imp}")
+
+    # the mocks must come from the generated package, not be re-declared or imported from elsewhere
+    if manifest["mocks_package"] and re.search(r'\bmocks\.', test_code):
+        mocks_package = f'"{expand_manifest_path(manifest["mocks_package"], code_to_test)}"'
+        if not is_imported(mocks_package):
+            problems.append(f"doesn't import the mocks from {mocks_package}")
+
+    return problems
+
+
+ANALYZER_DIR = os.path.join(os.path.dirname(os.path.abspath(__file__)), "analyzer")
+
+
+def call_analyzer(command, *args):
+    """
+    Run a command of the go analyzer, the paths it's given being absolute, and return its JSON output.
+    None when the files can't be analyzed.
+    """
+    res = subprocess.run(
+        ["go", "run", ".", command, *args],
+        cwd=ANALYZER_DIR,
+        capture_output=True,
+        text=True,
+    )
+    if res.returncode != 0:
+        print(f">> analyzer {command} failed on {' '.join(args)}: {res.stderr.strip()}")
+        return None
+
+    return json.loads(res.stdout)
+
+
+@functools.lru_cache(maxsize=None)
+def run_analyzer(command, path):
+    """
+    Same as call_analyzer for a single file, cached as the files we analyze don't change during a run.
+    """
+    return call_analyzer(command, os.path.abspath(path))
+
+
+def merge_tests(target, test_codes, append=False):
+    """
+    Merge the tests generated function by function, given by function name, into a single test file, and return its code.
+    The generated code that isn't valid go, and the declarations made twice, are left out rather than breaking the whole file.
+    None when the tests are appended to an existing file and can't be merged, the file being left as it is.
+    """
+    with tempfile.TemporaryDirectory() as directory:
+        paths = []
+        for name, test_code in test_codes.items():
+            paths.append(os.path.join(directory, f"{name}_test.go"))
+            with open(paths[-1], 'w', encoding='UTF-8') as part_f:
+                part_f.write(test_code)
+
+        merged = call_analyzer("merge", *paths) if paths else None
+
+    if merged is None:
+        if append:
+            # the existing tests and the generated ones concatenated would declare the package twice
+            print(f">> {target} left as it is, the generated tests can't be merged into it")
+            return None
+
+        # nothing to merge, keep what the model wrote so it can be fixed by hand
+        return "\n\n".join(test_codes.values())
+
+    for skipped in merged["skipped"]:
+        print(f">> {target} left out {skipped.removeprefix(directory + os.sep)}")
+
+    return merged["source"]
+
+
+def list_examples(codeType):
+    """
+    Return the prefixes of the code/test example pairs of a layer, the default code.go/code_test.go pair first.
+    """
+    prefixes = []
+    for code_path in glob.glob(f"./pkg/{codeType}/*code.go"):
+        prefix = os.path.basename(code_path).removesuffix("code.go")
+        if os.path.exists(f"./pkg/{codeType}/{prefix}code_test.go"):
+            prefixes.append(prefix)
+
+    return sorted(prefixes, key=lambda prefix: (prefix != "", prefix))
+
+
+def similarity(features, other_features):
+    """
+    Weighted Jaccard index of the traits of two files, the imports weighting less than the shape of the code.
+    Files with very different numbers of branches are a bit less similar.
+    """
+    def weight(trait):
+        return 1 if trait.startswith("import:") else 3
+
+    traits, other_traits = set(features["traits"]), set(other_features["traits"])
+    union = sum(weight(trait) for trait in traits | other_traits)
+    if union == 0:
+        return 0
+
+    score = sum(weight(trait) for trait in traits & other_traits) / union
+    return score - 0.01 * abs(features["branches"] - other_features["branches"])
+
+
+def get_example_paths(codeType, code_to_test, golden=False):
+    # large responder payloads are easier to review in golden files than in assert.Equal
+    if codeType == "handlers" and golden:
+        return f"./pkg/{codeType}/golden_code.go", f"./pkg/{codeType}/golden_code_test.go"
+
+    prefixes = [prefix for prefix in list_examples(codeType) if prefix not in read_manifest(codeType)["opt_in"]]
+
+    # pick the example closest to the code to test, e.g. a DAO doing writes learns from the write example
+    variant = prefixes[0]
+    features = run_analyzer("features", code_to_test) if len(prefixes) > 1 else None
+    if features:
+        variant = max(
+            prefixes,
+            key=lambda prefix: similarity(features, run_analyzer("features", f"./pkg/{codeType}/{prefix}code.go")),
+        )
+
+    return f"./pkg/{codeType}/{variant}code.go", f"./pkg/{codeType}/{variant}code_test.go"
+
+
+def analyze_target(code_to_test):
+    """
+    Look for patterns in the code to test that the examples can't teach, and return the notes telling the model how to handle them.
+    """
+    features = run_analyzer("features", code_to_test)
+    if features is None:
+        return []
+
+    notes = []
+
+    if features["time_now_lines"]:
+        notes.append(
+            f"The code calls time.Now() directly (line {', '.join(map(str, features['time_now_lines']))}), so the tests can't freeze the time. "
+            "Build the time-dependent fixtures relative to time.Now() with a wide margin, like time.Now().Add(-time.Hour) for the past, "
+            "never exactly on a boundary, and don't assert on exact timestamps computed by the code."
+        )
+
+    return notes
+
+
+# the layers having examples in ./pkg
+LAYERS = ["services", "handlers", "dao", "workers", "grpchandlers", "consumers", "clients", "middlewares", "utils", "di", "commands"]
+
+
+def get_layer(code_to_test):
+    """
+    Classify the code to test from what it declares, whatever the folder it lives in. None when it matches no layer.
+    """
+    classified = run_analyzer("layer", code_to_test)
+    layer = classified["layer"] if classified else ""
+
+    # a struct depending on interfaces is how most layers look, a folder named after a layer tells more
+    folder = os.path.basename(os.path.dirname(os.path.abspath(code_to_test)))
+    if layer in ("", "services") and folder in LAYERS:
+        return folder
+
+    if layer:
+        print(f">> {code_to_test} is in the {layer} layer: {classified['reason']}")
+
+    return layer or None
+
+
+# what each generation mode writes next to the code to test, and how it prompts the model
+MODES = {
+    "tests": {
+        "suffix": "_test.go",
+        # of the functions covering a function of the code, like TestXxx for Xxx
+        "prefix": "Test",
+        "instruction": """
+            Generate me test for this code.
+        """,
+    },
+    "fuzz": {
+        "suffix": "_fuzz_test.go",
+        "prefix": "Fuzz",
+        # fuzz tests look alike whatever the layer, they all learn from the same example
+        "examples": "fuzz",
+        "instruction": """
+            Generate me FuzzXxx functions using testing.F for the exported functions of this code taking strings, bytes or numbers.
+            Seed the corpus with f.Add using the inputs of the table cases of the existing tests when there are some.
+            Before each FuzzXxx function, state in a comment the invariants it checks, at least that the function never panics
+            and, when the code has an inverse function, that the round-trip gives back the input. Then check them in f.Fuzz.
+        """,
+        # the table cases of the existing tests are the seed corpus
+        "with_existing_tests": True,
+        # the functions the mode generates for
+        "candidates": lambda function: is_fuzzable(function),
+    },
+    "bench": {
+        "suffix": "_bench_test.go",
+        "prefix": "Benchmark",
+        "examples": "bench",
+        "instruction": """
+            Generate me BenchmarkXxx functions using testing.B for the exported functions of this code.
+            Declare the inputs inside each BenchmarkXxx function, copying the ones of the table cases of the existing tests when
+            there are some rather than sharing their tables, and run one b.Run per case.
+            Call b.ReportAllocs() and b.ResetTimer() once the fixtures are ready, then call the function b.N times.
+        """,
+        # the benchmarks copy the inputs of the table cases of the existing tests
+        "with_existing_tests": True,
+        "candidates": lambda function: is_benchmarkable(function),
+    },
+    "wire": {
+        "suffix": "_test.go",
+        "prefix": "Test",
+        "examples": "di",
+        # only the code generated by wire, the injectors declared in wire.go are given as context
+        "targets": "wire_gen.go",
+        "instruction": """
+            Generate me tests for the injectors of this code generated by wire.
+            Give the injectors local stand-ins, declared in the test file, for what needs a database or a network, like the pool
+            the transactions are begun from, then assert the injector builds the dependencies, that their Service and Tx fields are not nil, and that it returns the
+            provider errors.
+        """,
+    },
+}
+
+
+def paths_instructions(function):
+    """
+    List the return paths of a function as the table cases its tests must have.
+    """
+    if len(function["paths"]) <= 1:
+        return ""
+
+    instructions = f"The tests of {function['name']} must have one table case per path of the code, named exactly as follows:\n"
+    for path in function["paths"]:
+        instructions += f'- "{path["case"]}": `{path["statement"]}`'
+        if path["conditions"]:
+            instructions += " when " + " and ".join(path["conditions"])
+        instructions += "\n"
+
+    return instructions
+
+
+def dependencies_instructions(function):
+    """
+    Give the interfaces a function depends on that are declared in other files of its package, so their mocks are set up
+    with the right methods and arguments.
+    """
+    if not function["dependencies"]:
+        return ""
+
+    instructions = f"{function['name']} depends on these interfaces declared in other files of the package, mock their methods with these exact signatures:\n"
+    for file, dependencies in itertools.groupby(function["dependencies"], key=lambda dependency: dependency["file"]):
+        code = "\n\n".join(dependency["code"] for dependency in dependencies)
+        instructions += f"from {file}:\n```go\n{code}\n```\n"
+
+    return instructions
+
+
+def types_instructions(function):
+    """
+    Give the structs a function uses from the rest of the module and from its dependencies, so the fixtures are built with
+    their actual fields.
+    """
+    if not function["types"]:
+        return ""
+
+    code = "\n\n".join(definition["code"] for definition in function["types"])
+    return f"{function['name']} uses these structs declared outside of its file, build the fixtures with their actual fields:\n```go\n{code}\n```\n"
+
+
+def uncovered_paths(function, test_code):
+    """
+    Return the paths of a function with no table case named after them in the generated tests.
+    """
+    if len(function["paths"]) <= 1:
+        return []
+
+    return [path for path in function["paths"] if f'"{path["case"]}"' not in test_code]
+
+
+# the inputs the fuzzing engine can generate
+FUZZABLE_TYPE = r'string|\[\]byte|u?int(8|16|32|64)?|float(32|64)'
+
+
+def is_fuzzable(function):
+    """
+    Tell if a function given by the analyzer takes at least a string, []byte or number the fuzzing engine can generate.
+    """
+    return any(re.fullmatch(FUZZABLE_TYPE, param) for param in function["params"])
+
+
+def is_benchmarkable(function):
+    """
+    Tell if a function given by the analyzer computes something worth measuring: it takes inputs other than a context,
+    and returns results other than an error.
+    """
+    return (
+        any(param != "context.Context" for param in function["params"])
+        and any(result != "error" for result in function["results"])
+    )
+
+
+def generate_test(codeType, code_to_test, target, mode="tests", golden=False, append=False):
+    examplesType = MODES[mode].get("examples", codeType)
+    if MODES[mode].get("targets"):
+        # the generated file the mode targets keeps its name in the examples, like pkg/di/wire_gen.go
+        code_example_path = f"./pkg/{examplesType}/{MODES[mode]['targets']}"
+        test_example_path = code_example_path.removesuffix(".go") + "_test.go"
+    else:
+        code_example_path, test_example_path = get_example_paths(examplesType, code_to_test, golden)
+    manifest = read_manifest(examplesType)
+
+    # the examples of the modes writing a file of their own, like code_bench_test.go, come along with the tests of the code
+    mode_example_path = code_example_path.removesuffix(".go") + MODES[mode]["suffix"]
+    existing_example = ""
+    if os.path.exists(mode_example_path) and mode_example_path != test_example_path:
+        existing_example = f"""
+        existing tests of the example of code:
+        {read_code(test_example_path)}
+        """
+        test_example_path = mode_example_path
+    manifest = example_manifest(manifest, code_example_path, test_example_path)
+
+    # one generation per exported function keeps the prompts within the budget,
+    # and a function the model gets wrong doesn't ruin the tests of the others
+    if append:
+        functions = call_analyzer("untested", os.path.abspath(code_to_test), os.path.abspath(target), MODES[mode]["prefix"])
+        if functions is None:
+            print(f">> skipping {target}, its tests can't be parsed")
+            return
+        if not functions:
+            print(f">> {target} already tests every function")
+            return
+    else:
+        functions = run_analyzer("functions", code_to_test)
+
+    # only the functions the mode suits, like the ones taking strings or numbers to fuzz, are given to the model,
+    # the whole file being generated at once when it can't be analyzed
+    if functions is None:
+        functions = []
+    elif MODES[mode].get("candidates"):
+        functions = [function for function in functions if MODES[mode]["candidates"](function)]
+        if not functions:
+            print(f">> skipping {target}, none of its functions suits the {mode} mode")
+            return
+
+    print(">> starting generation for " + target)
+    system_instruct = f"""
+        You are a professional programmer and expert in the golang language.
+        I'm going to give you an example of code and associated tests.
+
+        example of code:
+        {read_code(code_example_path)}
+        {existing_example}
+        example of tests for the code:
+        {read_code(test_example_path)}
+    """
+    for helper in manifest["helpers"]:
+        helper_package = expand_manifest_path(helper["package"], code_to_test)
+        if helper_package == expand_manifest_path("{package}", code_to_test):
+            system_instruct += f"""
+        helper declared in the {os.path.basename(helper["path"])} file of the package of the tests, use it and don't declare it again:
+        {read_code(helper["path"])}
+        """
+        else:
+            system_instruct += f"""
+        helper package {helper_package} used by the tests, use it instead of re-writing what it does:
+        {read_code(helper["path"])}
+        """
+    context = ""
+
+    # the provider sets and injectors declarations wire generated the code from
+    if MODES[mode].get("targets") == "wire_gen.go":
+        for wire_path in sorted(glob.glob(f"./pkg/{examplesType}/*.go")):
+            if wire_path != code_example_path and not wire_path.endswith("_test.go"):
+                system_instruct += f"""
+        wire declarations of the example of code:
+        {read_code(wire_path)}
+        """
+
+        for wire_path in sorted(glob.glob(os.path.join(os.path.dirname(code_to_test), "*wire.go"))):
+            context += f"""
+        wire declarations of the code:
+        {read_code(wire_path)}
+        """
+
+    existing_test = code_to_test.removesuffix(".go") + "_test.go"
+    if MODES[mode].get("with_existing_tests") and os.path.exists(existing_test):
+        context += f"""
+        existing tests of the code:
+        {read_code(existing_test)}
+        """
+
+    if append and not (MODES[mode].get("with_existing_tests") and existing_test == target):
+        context += f"""
+        tests already written in the test file, reuse their helpers and fixtures rather than declaring them again:
+        {read_code(target)}
+        """
+
+    notes = analyze_target(code_to_test)
+    if notes:
+        context += "\nTake care of the following points:\n" + "\n".join(f"- {note}" for note in notes)
+
+    def build_message(function=None, split=False):
+        message = f"""
+        {MODES[mode]["instruction"]}
+        {manifest_instructions(manifest, code_to_test)}
+        """
+        if split:
+            message += f"""
+        Only test {function["name"]}, the rest of the code is given as context.
+        """
+        if function is not None:
+            message += paths_instructions(function) + dependencies_instructions(function) + types_instructions(function)
+
+        return message + f"""
+        {read_code(code_to_test, function["code"] if split else None)}
+        """ + context
+
+    def generate(function=None, split=False):
+        message = build_message(function, split)
+        try:
+            # call_chatgpt(system_instruct, message, target_f, 7800 - mistral_token_count(system_instruct + message))
+            return hg_api_mistral_inference(
+                system_instruct, message, io.StringIO(), 7800 - mistral_token_count(system_instruct + message),
+            )
+        except Exception as e:
+            print(f">> {target} failed to generate the tests of {function['name'] if function else code_to_test}: {e}")
+            return None
+
+    uncovered = []
+
+    if not append and len(functions) <= 1:
+        test_code = generate(functions[0] if functions else None)
+        if test_code is None:
+            return
+
+        for function in functions:
+            uncovered += [(function, path) for path in uncovered_paths(function, test_code)]
+    else:
+        test_codes = {}
+        for function in functions:
+            print(f">> generating for {function['name']} of {target}")
+            function_test_code = generate(function, split=True)
+            if function_test_code is None:
+                continue
+
+            test_codes[function["name"]] = function_test_code
+            uncovered += [(function, path) for path in uncovered_paths(function, function_test_code)]
+
+        if not test_codes:
+            return
+
+        if append:
+            # the existing tests come first so the merge leaves them as they are
+            with open(target, encoding='UTF-8') as existing_f:
+                test_codes = {os.path.basename(target).removesuffix("_test.go"): existing_f.read(), **test_codes}
+
+        test_code = merge_tests(target, test_codes, append)
+        if test_code is None:
+            return
+
+    with open(target, 'w', encoding='UTF-8') as target_f:
+        target_f.write(test_code)
+    install_helpers(manifest, code_to_test, test_code)
+
+    for problem in validate_test(manifest, code_to_test, test_code):
+        print(f">> {target} {problem}")
+
+    for function, path in uncovered:
+        print(f'>> {target} has no "{path["case"]}" case for the path of {function["name"]} line {path["line"]}')
 
     print(">> done")
 
+
+def is_generated(code_path):
+    """
+    Tell if a go file is generated, from the "// Code generated ... DO NOT EDIT." comment before its package clause.
+    """
+    with open(code_path, encoding='UTF-8') as code_f:
+        header = code_f.read().split("\npackage ", 1)[0]
+
+    return re.search(r'^// Code generated .* DO NOT EDIT\.$', header, flags=re.MULTILINE) is not None
+
+
 async def main():
+    parser = argparse.ArgumentParser(description="Generate go tests following the examples in the ./pkg folder.")
+    parser.add_argument("path", help="path to the folder of the service to test, its sub-folders are walked too")
+    parser.add_argument("--mode", choices=MODES.keys(), default="tests", help="kind of tests to generate")
+    parser.add_argument("--golden", action="store_true", help="compare the handlers responses to testdata/*.golden files")
+    parser.add_argument("--append", action="store_true", help="add the tests of the untested functions to the existing test files")
+    args = parser.parse_args()
+
     print(">> starting")
-    for codeType in ["services", "handlers", "dao"]: # , "handlers"
-        directory = sys.argv[1] + "/" + codeType
+    for directory, subdirectories, filenames in os.walk(args.path):
+        # the generated or vendored code isn't ours to test
+        subdirectories[:] = sorted(
+            subdirectory for subdirectory in subdirectories
+            if subdirectory not in ("mocks", "vendor", "testdata") and not subdirectory.startswith((".", "_"))
+        )
 
         calls = []
 
-        for file in os.listdir(directory):
-            filename = os.fsdecode(file)
-            test_filename = filename.split(".")[0]+ "_test.go"
-
-            if (
-                filename.endswith(".go")
-                # don't test tests
-                and not filename.endswith("_test.go")
-                # don't test wire
-                and not filename.endswith("_wire.go")
-                and not filename.endswith("wire_gen.go")
-
-                # other files
-                and not filename.endswith("utils.go")
-                and not filename.endswith("healthcheck.go")
-                and not filename.endswith("healthcheck_handler.go")
-
-                # don't override existing tests
-                and not os.path.exists(os.path.join(directory, test_filename))
-            ):
-                calls.append(
-                    (
-                        codeType,
-                        os.path.join(directory, filename),
-                        os.path.join(directory, test_filename),
-                    )
+        for filename in sorted(filenames):
+            test_filename = filename.split(".")[0] + MODES[args.mode]["suffix"]
+
+            if MODES[args.mode].get("targets"):
+                is_target = filename.endswith(MODES[args.mode]["targets"])
+            else:
+                is_target = (
+                    filename.endswith(".go")
+                    # don't test tests
+                    and not filename.endswith("_test.go")
+                    # don't test wire, see the wire mode
+                    and not filename.endswith("wire.go")
+                    # nor the code of the other generators, like sqlc or mockery
+                    and not is_generated(os.path.join(directory, filename))
+
+                    # other files
+                    and not filename.endswith("healthcheck.go")
+                    and not filename.endswith("healthcheck_handler.go")
                 )
 
-        print(f">> running for {len(calls)}")
+            # don't override existing tests, only add to them when appending
+            if not is_target:
+                continue
+            append = os.path.exists(os.path.join(directory, test_filename))
+            if append and not args.append:
+                continue
+
+            layer = get_layer(os.path.join(directory, filename))
+            if layer is None:
+                print(f">> skipping {os.path.join(directory, filename)}, it matches no layer")
+                continue
+            if layer == "di" and args.mode != "wire":
+                print(f">> skipping {os.path.join(directory, filename)}, the injectors are tested with --mode wire")
+                continue
+
+            calls.append(
+                (
+                    layer,
+                    os.path.join(directory, filename),
+                    os.path.join(directory, test_filename),
+                    args.mode,
+                    args.golden,
+                    append,
+                )
+            )
+
+        if not calls:
+            continue
+
+        print(f">> running for {len(calls)} in {directory}")
         await asyncio.gather(
-            *[asyncio.to_thread(generate_test, *args) for args in calls]
+            *[asyncio.to_thread(generate_test, *call) for call in calls]
         )
 
 if __name__ == '__main__':
@@ -0,0 +1,40 @@
+package bench
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/itchyny/gojq"
+	"github.com/juju/errors"
+)
+
+// ComputeDeduplicationKey runs the jq de-duplication instruction of a task step on its environment,
+// and returns the JSON of the first value it yields. It's empty when there is nothing to de-duplicate on.
+func ComputeDeduplicationKey(ctx context.Context, jq string, environment map[string]interface{}) (string, error) {
+	// if we have no instruction to de-duplicate the task, we skip
+	if jq == "" {
+		return "", nil
+	}
+
+	query, err := gojq.Parse(jq)
+	if err != nil {
+		return "", errors.Annotate(err, "parse parameters while checking task duplicated")
+	}
+
+	deduplicatingValues, ok := query.RunWithContext(ctx, environment).Next()
+	// if the jq yielded no instruction to de-duplicate the task, we skip
+	if !ok {
+		return "", nil
+	}
+
+	if err, ok := deduplicatingValues.(error); ok {
+		return "", errors.Annotate(err, "failed to process jq with context for task duplicated")
+	}
+
+	deduplicatingValuesBytes, err := json.Marshal(deduplicatingValues)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to convert the jq into json while checking task duplicated")
+	}
+
+	return string(deduplicatingValuesBytes), nil
+}
@@ -0,0 +1,64 @@
+package bench_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Work4Labs/uservice-applications/pkg/bench"
+)
+
+func BenchmarkComputeDeduplicationKey(b *testing.B) {
+	ctx := context.Background()
+
+	// the environment and the instructions of the table cases of TestComputeDeduplicationKey
+	environment := map[string]interface{}{
+		"application": map[string]interface{}{
+			"id":                "00000000-0000-0000-0000-000000000010",
+			"organization_name": "seiza",
+			"answers": []interface{}{
+				map[string]interface{}{"question_label": "question one", "answer": "oui"},
+				map[string]interface{}{"question_label": "question two", "answer": "non"},
+			},
+		},
+	}
+
+	flagBenchComputeDeduplicationKey := []struct {
+		name string
+
+		jq string
+	}{
+		{
+			name: "ok",
+
+			jq: `{id: .application.id}`,
+		},
+		{
+			name: "ok - nested values",
+
+			jq: `[.application.answers[].answer]`,
+		},
+		{
+			name: "ok - no instruction",
+
+			jq: "",
+		},
+		{
+			name: "ko - invalid jq",
+
+			jq: `{id: `,
+		},
+	}
+
+	for _, tt := range flagBenchComputeDeduplicationKey {
+		tt := tt
+
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = bench.ComputeDeduplicationKey(ctx, tt.jq, environment)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package bench_test
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/Work4Labs/uservice-applications/pkg/bench"
+)
+
+func TestComputeDeduplicationKey(t *testing.T) {
+	ctx := context.Background()
+
+	environment := map[string]interface{}{
+		"application": map[string]interface{}{
+			"id":                "00000000-0000-0000-0000-000000000010",
+			"organization_name": "seiza",
+			"answers": []interface{}{
+				map[string]interface{}{"question_label": "question one", "answer": "oui"},
+				map[string]interface{}{"question_label": "question two", "answer": "non"},
+			},
+		},
+	}
+
+	flagTestComputeDeduplicationKey := []struct {
+		name string
+
+		jq string
+
+		expectedRes string
+		expectErr   bool
+	}{
+		{
+			name: "ok",
+
+			jq: `{id: .application.id}`,
+
+			expectedRes: `{"id":"00000000-0000-0000-0000-000000000010"}`,
+		},
+		{
+			name: "ok - nested values",
+
+			jq: `[.application.answers[].answer]`,
+
+			expectedRes: `["oui","non"]`,
+		},
+		{
+			name: "ok - no instruction",
+
+			jq: "",
+
+			expectedRes: "",
+		},
+		{
+			name: "ok - no value yielded",
+
+			jq: `empty`,
+
+			expectedRes: "",
+		},
+		{
+			name: "ko - invalid jq",
+
+			jq: `{id: `,
+
+			expectErr: true,
+		},
+		{
+			name: "ko - jq runtime error",
+
+			jq: `.application.id + 1`,
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range flagTestComputeDeduplicationKey {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			res, err := bench.ComputeDeduplicationKey(ctx, tt.jq, environment)
+			assert.Equal(tt.expectErr, err != nil)
+			assert.Equal(tt.expectedRes, res)
+		})
+	}
+}
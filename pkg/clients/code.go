@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+var (
+	ErrNotFound          = errors.New("resource not found")
+	ErrUnexpectedStatus  = errors.New("unexpected status code")
+	ErrTimeout           = errors.New("request timed out")
+	ErrMalformedResponse = errors.New("malformed response")
+)
+
+type Answer struct {
+	QuestionLabel string `json:"question_label"`
+	Answer        string `json:"answer"`
+}
+
+type Score struct {
+	Score   float64  `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+type Scoring struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+func NewScoring(baseURL string, timeout time.Duration) *Scoring {
+	return &Scoring{
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// ScoreApplication asks the scoring service to score the answers of an application, on behalf of the user owning token.
+func (c *Scoring) ScoreApplication(ctx context.Context, token, applicationID string, answers []Answer) (*Score, error) {
+	body, err := json.Marshal(map[string]interface{}{"answers": answers})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.BaseURL+"/applications/"+url.PathEscape(applicationID)+"/score",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil, fmt.Errorf("scoring application '%s': %w", applicationID, ErrTimeout)
+		}
+
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("scoring application '%s': %w", applicationID, ErrNotFound)
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		return nil, fmt.Errorf("scoring application '%s' returned %d: %w", applicationID, res.StatusCode, ErrUnexpectedStatus)
+	}
+
+	score := new(Score)
+	if err = json.NewDecoder(res.Body).Decode(score); err != nil {
+		return nil, fmt.Errorf("scoring application '%s': %s: %w", applicationID, err, ErrMalformedResponse)
+	}
+
+	return score, nil
+}
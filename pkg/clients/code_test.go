@@ -0,0 +1,130 @@
+package clients_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/Work4Labs/uservice-applications/pkg/clients"
+)
+
+func TestScoreApplication(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		token         = "user-token"
+		applicationID = "00000000-0000-0000-0000-000000000010"
+		timeout       = 100 * time.Millisecond
+	)
+
+	var (
+		answers = []clients.Answer{
+			{QuestionLabel: "question one", Answer: "oui"},
+			{QuestionLabel: "question two", Answer: "non"},
+		}
+	)
+
+	flagTestScoreApplication := []struct {
+		name string
+
+		serverDelay    time.Duration
+		responseStatus int
+		responseBody   string
+
+		expectedRes *clients.Score
+		expectedErr error
+	}{
+		{
+			name: "ok",
+
+			responseStatus: http.StatusOK,
+			responseBody:   `{"score": 0.8, "reasons": ["experience"]}`,
+
+			expectedRes: &clients.Score{Score: 0.8, Reasons: []string{"experience"}},
+			expectedErr: nil,
+		},
+		{
+			name: "ko - not found",
+
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"error": "not found"}`,
+
+			expectedRes: nil,
+			expectedErr: clients.ErrNotFound,
+		},
+		{
+			name: "ko - server error",
+
+			responseStatus: http.StatusInternalServerError,
+			responseBody:   `{"error": "boom"}`,
+
+			expectedRes: nil,
+			expectedErr: clients.ErrUnexpectedStatus,
+		},
+		{
+			name: "ko - timeout",
+
+			serverDelay:    5 * timeout,
+			responseStatus: http.StatusOK,
+			responseBody:   `{"score": 0.8}`,
+
+			expectedRes: nil,
+			expectedErr: clients.ErrTimeout,
+		},
+		{
+			name: "ko - malformed json",
+
+			responseStatus: http.StatusOK,
+			responseBody:   `{"score": `,
+
+			expectedRes: nil,
+			expectedErr: clients.ErrMalformedResponse,
+		},
+	}
+
+	for _, tt := range flagTestScoreApplication {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			// released when the case is over, so a slow handler doesn't keep the server from closing
+			done := make(chan struct{})
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(http.MethodPost, r.Method)
+				assert.Equal("/applications/"+applicationID+"/score", r.URL.Path)
+				assert.Equal("Bearer "+token, r.Header.Get("Authorization"))
+				assert.Equal("application/json", r.Header.Get("Content-Type"))
+
+				body := struct {
+					Answers []clients.Answer `json:"answers"`
+				}{}
+				assert.NoError(json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(answers, body.Answers)
+
+				select {
+				case <-time.After(tt.serverDelay):
+				case <-done:
+					return
+				}
+
+				w.WriteHeader(tt.responseStatus)
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+			defer close(done)
+
+			client := clients.NewScoring(server.URL, timeout)
+
+			res, err := client.ScoreApplication(ctx, token, applicationID, answers)
+			assert.ErrorIs(err, tt.expectedErr)
+			assert.Equal(tt.expectedRes, res)
+		})
+	}
+}
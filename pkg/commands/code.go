@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+type CommentCreator interface {
+	CreateApplicationComment(ctx context.Context, applicationExternalID, content, userEntityID, kind string, isBulkAction bool) (int64, error)
+}
+
+// NewCreateCommentCommand returns the command adding a comment to an application on behalf of a user.
+func NewCreateCommentCommand(creator CommentCreator) *cobra.Command {
+	var (
+		userEntityID string
+		kind         string
+		isBulkAction bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create-comment APPLICATION_ID CONTENT",
+		Short: "Add a comment to an application",
+		Args:  cobra.ExactArgs(2),
+		// the usage is noise when the error comes from the service
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commentID, err := creator.CreateApplicationComment(cmd.Context(), args[0], args[1], userEntityID, kind, isBulkAction)
+			if err != nil {
+				return fmt.Errorf("create comment on application '%s': %w", args[0], err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "comment %d created\n", commentID)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&userEntityID, "user", "", "id of the user writing the comment")
+	cmd.Flags().StringVar(&kind, "kind", "COMMENT", "kind of the comment")
+	cmd.Flags().BoolVar(&isBulkAction, "bulk", false, "whether the comment is part of a bulk action")
+	_ = cmd.MarkFlagRequired("user")
+
+	return cmd
+}
@@ -0,0 +1,140 @@
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Work4Labs/uservice-applications/pkg/commands"
+	"github.com/Work4Labs/uservice-applications/pkg/commands/mocks"
+)
+
+func TestCreateCommentCommand(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		applicationID = "00000000-0000-0000-0000-000000000010"
+		userEntityID  = "00000000-0000-0000-0000-000000000011"
+	)
+
+	var (
+		errCreator = errors.New("failed to create comment")
+	)
+
+	flagTestCreateCommentCommand := []struct {
+		name string
+
+		args []string
+
+		creatorCalled       bool
+		creatorKind         string
+		creatorIsBulkAction bool
+		creatorRes          int64
+		creatorErr          error
+
+		expectedOut   string
+		expectErr     bool
+		expectedErrIs error
+	}{
+		{
+			name: "ok",
+
+			args: []string{applicationID, "content", "--user", userEntityID},
+
+			creatorCalled: true,
+			creatorKind:   "COMMENT",
+			creatorRes:    1,
+
+			expectedOut: "comment 1 created\n",
+		},
+		{
+			name: "ok - with flags",
+
+			args: []string{applicationID, "content", "--user", userEntityID, "--kind", "INTERVIEW_CANCELLED", "--bulk"},
+
+			creatorCalled:       true,
+			creatorKind:         "INTERVIEW_CANCELLED",
+			creatorIsBulkAction: true,
+			creatorRes:          2,
+
+			expectedOut: "comment 2 created\n",
+		},
+		{
+			name: "ko - missing content argument",
+
+			args: []string{applicationID, "--user", userEntityID},
+
+			expectErr: true,
+		},
+		{
+			name: "ko - missing required user flag",
+
+			args: []string{applicationID, "content"},
+
+			expectErr: true,
+		},
+		{
+			name: "ko - unknown flag",
+
+			args: []string{applicationID, "content", "--user", userEntityID, "--unknown"},
+
+			expectErr: true,
+		},
+		{
+			name: "ko - creator error",
+
+			args: []string{applicationID, "content", "--user", userEntityID},
+
+			creatorCalled: true,
+			creatorKind:   "COMMENT",
+			creatorErr:    errCreator,
+
+			expectErr:     true,
+			expectedErrIs: errCreator,
+		},
+	}
+
+	for _, tt := range flagTestCreateCommentCommand {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			creator := &mocks.CommentCreator{}
+			creator.On(
+				"CreateApplicationComment",
+				mock.Anything,
+				applicationID,
+				"content",
+				userEntityID,
+				tt.creatorKind,
+				tt.creatorIsBulkAction,
+			).Return(tt.creatorRes, tt.creatorErr)
+
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+			cmd := commands.NewCreateCommentCommand(creator)
+			cmd.SetOut(stdout)
+			cmd.SetErr(stderr)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.ExecuteContext(ctx)
+			assert.Equal(tt.expectErr, err != nil)
+			if tt.expectedErrIs != nil {
+				assert.ErrorIs(err, tt.expectedErrIs)
+			}
+
+			assert.Equal(tt.expectedOut, stdout.String())
+
+			if tt.creatorCalled {
+				creator.AssertExpectations(t)
+			} else {
+				creator.AssertNotCalled(t, "CreateApplicationComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
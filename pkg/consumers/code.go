@@ -0,0 +1,103 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Work4Labs/go_framework/db"
+	"github.com/Work4Labs/go_framework/helpers"
+
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/services"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type Message interface {
+	ID() string
+	Body() []byte
+	Ack() error
+	Nack(requeue bool) error
+}
+
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+type ApplicationCommentService interface {
+	CreateApplicationComment(ctx context.Context, applicationExternalID, content, userEntityID, kind string, isBulkAction bool) (int64, error)
+}
+
+type CreateApplicationCommentDependencies struct {
+	Service ApplicationCommentService
+	Tx      Tx
+}
+
+type CreateApplicationCommentServiceFactory func(ctx context.Context) (*CreateApplicationCommentDependencies, error)
+
+type CreateApplicationCommentEvent struct {
+	ApplicationID string `json:"application_id"`
+	Content       string `json:"content"`
+	UserEntityID  string `json:"user_entity_id"`
+	Kind          string `json:"kind"`
+	IsBulkAction  bool   `json:"is_bulk_action"`
+}
+
+type CreateApplicationComment struct {
+	ServiceFactory CreateApplicationCommentServiceFactory
+}
+
+func NewCreateApplicationComment() *CreateApplicationComment {
+	return &CreateApplicationComment{ServiceFactory: InitCreateApplicationCommentDependencies}
+}
+
+// Consume creates the comment described by the message, then acks it.
+// Messages that can never succeed are dead-lettered, the others are requeued to be retried.
+func (c *CreateApplicationComment) Consume(msg Message) error {
+	ctx, logger := helpers.ContextWithLog(
+		context.Background(),
+		log.Fields{"message_id": msg.ID()},
+	)
+
+	event := CreateApplicationCommentEvent{}
+	if err := json.Unmarshal(msg.Body(), &event); err != nil {
+		logger.WithError(err).Error("failed to decode create application comment message")
+		return msg.Nack(false)
+	}
+
+	deps, err := c.ServiceFactory(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to create dependencies for create application comment consumer")
+		return msg.Nack(true)
+	}
+
+	defer func() {
+		db.CleanTx(ctx, deps.Tx, err)
+	}()
+
+	_, err = deps.Service.CreateApplicationComment(
+		ctx,
+		event.ApplicationID,
+		event.Content,
+		event.UserEntityID,
+		event.Kind,
+		event.IsBulkAction,
+	)
+
+	switch {
+	// the message was already consumed once, nothing left to do
+	case errors.Is(err, dao.ErrCommentAlreadyExists):
+		return msg.Ack()
+	// retrying won't fix those
+	case errors.Is(err, services.ErrInvalidCommentKind), errors.Is(err, dao.ErrApplicationNotFound):
+		logger.WithError(err).Warn("dead-lettering create application comment message")
+		return msg.Nack(false)
+	case err != nil:
+		logger.WithError(err).Error("failed to handle create application comment message")
+		return msg.Nack(true)
+	}
+
+	return msg.Ack()
+}
@@ -0,0 +1,202 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/samber/lo"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Work4Labs/uservice-applications/pkg/consumers"
+	"github.com/Work4Labs/uservice-applications/pkg/consumers/mocks"
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/services"
+)
+
+const (
+	outcomeAck        = "ack"
+	outcomeRequeue    = "requeue"
+	outcomeDeadLetter = "dead-letter"
+)
+
+// message is an in-memory stand-in for a broker message, it records how it was settled.
+type message struct {
+	body    []byte
+	outcome string
+}
+
+func (m *message) ID() string {
+	return "message_id"
+}
+
+func (m *message) Body() []byte {
+	return m.body
+}
+
+func (m *message) Ack() error {
+	m.outcome = outcomeAck
+	return nil
+}
+
+func (m *message) Nack(requeue bool) error {
+	m.outcome = lo.Ternary(requeue, outcomeRequeue, outcomeDeadLetter)
+	return nil
+}
+
+func TestCreateApplicationComment(t *testing.T) {
+	const (
+		validBody = `{
+			"application_id": "00000000-0000-0000-0000-000000000010",
+			"content": "content",
+			"user_entity_id": "00000000-0000-0000-0000-000000000011",
+			"kind": "COMMENT",
+			"is_bulk_action": false
+		}`
+	)
+
+	var (
+		errService = errors.New("fail during service")
+	)
+
+	flagTestCreateApplicationComment := []struct {
+		name string
+
+		body string
+
+		depsErr error
+
+		serviceCalled bool
+		serviceErr    error
+
+		expectedOutcome string
+
+		commitCalled *bool
+	}{
+		{
+			name: "ok",
+
+			body: validBody,
+
+			serviceCalled: true,
+
+			expectedOutcome: outcomeAck,
+			commitCalled:    lo.ToPtr(true),
+		},
+		{
+			name: "ok - already consumed",
+
+			body: validBody,
+
+			serviceCalled: true,
+			serviceErr:    dao.ErrCommentAlreadyExists,
+
+			expectedOutcome: outcomeAck,
+			commitCalled:    lo.ToPtr(false),
+		},
+		{
+			name: "ko - malformed body",
+
+			body: `{"application_id": `,
+
+			expectedOutcome: outcomeDeadLetter,
+			commitCalled:    nil,
+		},
+		{
+			name: "ko - init service",
+
+			body: validBody,
+
+			depsErr: errors.New("failed to init service"),
+
+			expectedOutcome: outcomeRequeue,
+			commitCalled:    nil,
+		},
+		{
+			name: "ko - invalid kind",
+
+			body: validBody,
+
+			serviceCalled: true,
+			serviceErr:    services.ErrInvalidCommentKind,
+
+			expectedOutcome: outcomeDeadLetter,
+			commitCalled:    lo.ToPtr(false),
+		},
+		{
+			name: "ko - application not found",
+
+			body: validBody,
+
+			serviceCalled: true,
+			serviceErr:    dao.ErrApplicationNotFound,
+
+			expectedOutcome: outcomeDeadLetter,
+			commitCalled:    lo.ToPtr(false),
+		},
+		{
+			name: "ko - service error",
+
+			body: validBody,
+
+			serviceCalled: true,
+			serviceErr:    errService,
+
+			expectedOutcome: outcomeRequeue,
+			commitCalled:    lo.ToPtr(false),
+		},
+	}
+
+	for _, tt := range flagTestCreateApplicationComment {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			service := &mocks.ApplicationCommentService{}
+			service.On(
+				"CreateApplicationComment",
+				mock.Anything,
+				"00000000-0000-0000-0000-000000000010",
+				"content",
+				"00000000-0000-0000-0000-000000000011",
+				"COMMENT",
+				false,
+			).Return(int64(1), tt.serviceErr)
+
+			tx := &mocks.Tx{}
+			tx.On("Commit", mock.Anything).Return(nil)
+			tx.On("Rollback", mock.Anything).Return(nil)
+
+			consumer := &consumers.CreateApplicationComment{
+				ServiceFactory: func(ctx context.Context) (*consumers.CreateApplicationCommentDependencies, error) {
+					return &consumers.CreateApplicationCommentDependencies{
+						Service: service,
+						Tx:      tx,
+					}, tt.depsErr
+				},
+			}
+
+			msg := &message{body: []byte(tt.body)}
+
+			err := consumer.Consume(msg)
+			assert.NoError(err)
+			assert.Equal(tt.expectedOutcome, msg.outcome)
+
+			if tt.serviceCalled {
+				service.AssertExpectations(t)
+			} else {
+				service.AssertNotCalled(t, "CreateApplicationComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			if tt.commitCalled != nil {
+				if *tt.commitCalled {
+					tx.AssertCalled(t, "Commit", mock.Anything)
+				} else {
+					tx.AssertCalled(t, "Rollback", mock.Anything)
+				}
+			}
+		})
+	}
+}
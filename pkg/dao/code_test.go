@@ -6,12 +6,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/samber/lo"
 	tassert "github.com/stretchr/testify/assert"
 
 	"github.com/Work4Labs/uservice-applications/pkg/dao"
 	"github.com/Work4Labs/uservice-applications/pkg/database"
+	"github.com/Work4Labs/uservice-applications/pkg/dbtest"
 	"github.com/Work4Labs/uservice-applications/pkg/entities"
 )
 
@@ -36,7 +36,7 @@ func TestGetApplication(t *testing.T) {
 		commentID       = 5
 		secondCommentID = 50
 		content         = "this is a comment"
-		secondContent   = "this is a comment"
+		secondContent   = "this is another comment"
 		userEntityID    = "00000000-0000-0000-0000-000000000011"
 
 		statusID               = 6
@@ -153,86 +153,9 @@ func TestGetApplication(t *testing.T) {
 
 	ctx := context.Background()
 
-	initDBForApplication := func(ctx context.Context, tx pgx.Tx) (err error) {
-		// start the tx
-		_, err = tx.Exec(ctx, `
-			CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
-			CREATE SCHEMA IF NOT EXISTS applications;
-
-			CREATE TABLE applications.applications (
-				id SERIAL PRIMARY KEY,
-				external_id TEXT,
-
-				job_id TEXT NOT NULL,
-				organization_name TEXT,
-
-				campaign_id TEXT NOT NULL,
-				candidate_id CHARACTER VARYING(36),
-
-				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
-
-				last_interaction_date timestamptz NOT NULL DEFAULT NOW()
-			);
-			
-			CREATE TABLE applications.answers (
-				id BIGINT PRIMARY KEY,
-				application_id BIGINT NOT NULL,
-				question_label TEXT NOT NULL,
-				answer TEXT NOT NULL,
-				CONSTRAINT answers FOREIGN KEY (application_id) REFERENCES applications.applications (id)
-			);
-
-			CREATE TYPE comment_kind AS ENUM (
-				'COMMENT',
-				'INTERVIEW_CANCELLED'
-			);
-
-			CREATE TABLE applications.comments (
-				id BIGINT  PRIMARY KEY,
-				application_id BIGINT NOT NULL,
-				content TEXT NOT NULL,
-				user_entity_id CHARACTER VARYING(36) NOT NULL,
-				kind comment_kind NOT NULL DEFAULT 'COMMENT',
-				"is_bulk_action" BOOLEAN NOT NULL DEFAULT FALSE,
-
-				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-				CONSTRAINT comments FOREIGN KEY (application_id) REFERENCES applications.applications (id)
-			);
-
-			CREATE TABLE applications.statuses (
-				id BIGINT PRIMARY KEY,
-				external_id uuid DEFAULT uuid_generate_v4() NOT NULL UNIQUE,
-				label TEXT NOT NULL
-			);
-
-			CREATE TABLE applications.application_statuses (
-				id BIGINT PRIMARY KEY,
-				external_id TEXT,
-
-				application_id BIGINT NOT NULL,
-				status_id BIGINT NOT NULL,
-				user_entity_id CHARACTER VARYING(36) NOT NULL,
-				
-				"is_bulk_action" BOOLEAN NOT NULL DEFAULT FALSE,
-			
-				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-			
-				CONSTRAINT application_fk FOREIGN KEY (application_id) REFERENCES applications.applications (id),
-				CONSTRAINT status_fk FOREIGN KEY (status_id) REFERENCES applications.statuses (id) ON DELETE SET NULL
-			);
-		`)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-
-	fixturesForApplication := func(ctx context.Context, tx pgx.Tx) (err error) {
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.applications (
+	fixturesForApplication := []dbtest.Fixture{
+		{
+			Query: `INSERT INTO applications.applications (
 				id,
 				external_id,
 				organization_name,
@@ -243,149 +166,69 @@ func TestGetApplication(t *testing.T) {
 				created_at,
 				updated_at
 			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
-			applicationID,
-			applicationExternalID,
-			organizationName,
-			jobID,
-			campaignID,
-			candidateID,
-			lastInteractionDate,
-			createdAt,
-			updatedAt,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.answers (
+			Args: []any{
+				applicationID,
+				applicationExternalID,
+				organizationName,
+				jobID,
+				campaignID,
+				candidateID,
+				lastInteractionDate,
+				createdAt,
+				updatedAt,
+			},
+		},
+		{
+			Query: `INSERT INTO applications.answers (
 				id, application_id, question_label, answer
 			) VALUES ($1, $2, $3, $4);`,
-			answerID,
-			applicationID,
-			questionLabel,
-			answerLabel,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.answers (
+			Args: []any{answerID, applicationID, questionLabel, answerLabel},
+		},
+		{
+			Query: `INSERT INTO applications.answers (
 				id, application_id, question_label, answer
 			) VALUES ($1, $2, $3, $4);`,
-			secondAnswerID,
-			applicationID,
-			secondQuestionLabel,
-			secondAnswerLabel,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.comments (
+			Args: []any{secondAnswerID, applicationID, secondQuestionLabel, secondAnswerLabel},
+		},
+		{
+			Query: `INSERT INTO applications.comments (
 				id, application_id, content, is_bulk_action, user_entity_id, created_at
 			) VALUES ($1, $2, $3, $4, $5, $6);`,
-			commentID,
-			applicationID,
-			content,
-			isBulkAction,
-			userEntityID,
-			createdAt,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.comments (
+			Args: []any{commentID, applicationID, content, isBulkAction, userEntityID, createdAt},
+		},
+		{
+			Query: `INSERT INTO applications.comments (
 				id, application_id, content, is_bulk_action, user_entity_id, created_at
 			) VALUES ($1, $2, $3, $4, $5, $6);`,
-			secondCommentID,
-			applicationID,
-			secondContent,
-			isBulkAction,
-			userEntityID,
-			secondCreatedAt,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.statuses (
+			Args: []any{secondCommentID, applicationID, secondContent, isBulkAction, userEntityID, secondCreatedAt},
+		},
+		{
+			Query: `INSERT INTO applications.statuses (
 				id, external_id, label
 			) VALUES ($1, $2, $3);`,
-			statusID,
-			statusExternalID,
-			statusLabel,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.statuses (
+			Args: []any{statusID, statusExternalID, statusLabel},
+		},
+		{
+			Query: `INSERT INTO applications.statuses (
 				id, external_id, label
 			) VALUES ($1, $2, $3);`,
-			secondStatusID,
-			secondStatusExternalID,
-			secondStatusLabel,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.application_statuses (
+			Args: []any{secondStatusID, secondStatusExternalID, secondStatusLabel},
+		},
+		{
+			Query: `INSERT INTO applications.application_statuses (
 				id, external_id, application_id, status_id, user_entity_id, is_bulk_action, created_at
 			) VALUES ($1, $2, $3, $4, $5, $6, $7);`,
-			applicationStatusID,
-			applicationStatusExternalID,
-			applicationID,
-			statusID,
-			userEntityID,
-			isBulkAction,
-			createdAt,
-		)
-		if err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(
-			ctx,
-			`INSERT INTO applications.application_statuses (
+			Args: []any{applicationStatusID, applicationStatusExternalID, applicationID, statusID, userEntityID, isBulkAction, createdAt},
+		},
+		{
+			Query: `INSERT INTO applications.application_statuses (
 				id, external_id, application_id, status_id, user_entity_id, is_bulk_action, created_at
 			) VALUES ($1, $2, $3, $4, $5, $6, $7);`,
-			applicationSecondStatusID,
-			applicationStatusSecondExternalID,
-			applicationID,
-			secondStatusID,
-			userEntityID,
-			isBulkAction,
-			secondCreatedAt,
-		)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}
-
-	db, err := connect(ctx)
-	if err != nil {
-		t.Fatal(err)
+			Args: []any{applicationSecondStatusID, applicationStatusSecondExternalID, applicationID, secondStatusID, userEntityID, isBulkAction, secondCreatedAt},
+		},
 	}
 
-	defer db.Close()
+	db := dbtest.Connect(t)
 
 	flagTestGetApplication := []struct {
 		name string
@@ -435,29 +278,9 @@ func TestGetApplication(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := tassert.New(t)
 
-			tx, err := db.Begin(ctx)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			// rollback to clean the DB
-			defer func() {
-				err = tx.Rollback(ctx)
-				if err != nil {
-					t.Fatal(err)
-				}
-			}()
-
-			if err = initDBForApplication(ctx, tx); err != nil {
-				t.Error(err)
-				return
-			}
-
-			err = fixturesForApplication(ctx, tx)
-			if err != nil {
-				t.Error(err)
-				return
-			}
+			tx := dbtest.Tx(t, db)
+			dbtest.Bootstrap(t, tx)
+			dbtest.LoadFixtures(t, tx, fixturesForApplication...)
 
 			// Call the DAO to do the insert
 			applicationDAO := dao.NewApplication(tx)
@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	ErrApplicationNotFound  = errors.New("application not found")
+	ErrCommentAlreadyExists = errors.New("comment already exists")
+)
+
+type Comment struct {
+	DB pgx.Tx
+}
+
+func NewComment(tx pgx.Tx) *Comment {
+	return &Comment{
+		DB: tx,
+	}
+}
+
+var createApplicationCommentQuery string = `INSERT INTO applications.comments (
+    application_id,
+    content,
+    user_entity_id,
+    kind,
+    is_bulk_action
+)
+SELECT a.id, $2, $3, $4, $5
+FROM applications.applications a
+WHERE a.external_id = $1
+RETURNING id, application_id
+`
+
+var touchApplicationQuery string = `UPDATE applications.applications
+SET last_interaction_date = NOW(), updated_at = NOW()
+WHERE id = $1
+`
+
+// CreateApplicationComment inserts a comment and bumps the last interaction date of its application.
+func (c *Comment) CreateApplicationComment(ctx context.Context, applicationExternalID, content, userEntityID, kind string, isBulkAction bool) (int64, error) {
+	var commentID, applicationID int64
+
+	err := c.DB.QueryRow(
+		ctx, createApplicationCommentQuery,
+		applicationExternalID, content, userEntityID, kind, isBulkAction,
+	).Scan(&commentID, &applicationID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("application '%s': %w", applicationExternalID, ErrApplicationNotFound)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+		return 0, fmt.Errorf("comment on application '%s': %w", applicationExternalID, ErrCommentAlreadyExists)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := c.DB.Exec(ctx, touchApplicationQuery, applicationID)
+	if err != nil {
+		return 0, err
+	}
+
+	if tag.RowsAffected() != 1 {
+		return 0, fmt.Errorf("application '%s': %w", applicationExternalID, ErrApplicationNotFound)
+	}
+
+	return commentID, nil
+}
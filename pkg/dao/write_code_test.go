@@ -0,0 +1,157 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/dbtest"
+)
+
+func TestCreateApplicationComment(t *testing.T) {
+	const (
+		applicationID = 3
+
+		applicationExternalID = "00000000-0000-0000-0000-000000000010"
+		unknownExternalID     = "00000000-0000-0000-0000-000000000099"
+		organizationName      = "organization_name"
+
+		jobID       = "00000000-0000-0000-0000-000000000110"
+		campaignID  = "00000000-0000-0000-0000-000000000013"
+		candidateID = "00000000-0000-0000-0000-000000000014"
+
+		lastInteractionDate = "2023-04-06 13:14:51"
+
+		existingCommentID = 5
+		existingContent   = "this is a comment"
+		content           = "this is another comment"
+		userEntityID      = "00000000-0000-0000-0000-000000000011"
+		kind              = "COMMENT"
+
+		isBulkAction = true
+	)
+
+	ctx := context.Background()
+
+	fixturesForComment := []dbtest.Fixture{
+		{
+			Query: `INSERT INTO applications.applications (
+				id,
+				external_id,
+				organization_name,
+				job_id,
+				campaign_id,
+				candidate_id,
+				last_interaction_date
+			) VALUES ($1, $2, $3, $4, $5, $6, $7);`,
+			Args: []any{applicationID, applicationExternalID, organizationName, jobID, campaignID, candidateID, lastInteractionDate},
+		},
+		{
+			Query: `INSERT INTO applications.comments (
+				id, application_id, content, is_bulk_action, user_entity_id
+			) VALUES ($1, $2, $3, $4, $5);`,
+			Args: []any{existingCommentID, applicationID, existingContent, isBulkAction, userEntityID},
+		},
+	}
+
+	db := dbtest.Connect(t)
+
+	flagTestCreateApplicationComment := []struct {
+		name string
+
+		applicationExternalID string
+		content               string
+
+		expectedComments int
+		expectedErr      error
+	}{
+		{
+			name: "ok",
+
+			applicationExternalID: applicationExternalID,
+			content:               content,
+
+			expectedComments: 2,
+			expectedErr:      nil,
+		},
+		{
+			name: "ko - application not found",
+
+			applicationExternalID: unknownExternalID,
+			content:               content,
+
+			expectedComments: 1,
+			expectedErr:      dao.ErrApplicationNotFound,
+		},
+		{
+			name: "ko - comment already exists",
+
+			applicationExternalID: applicationExternalID,
+			content:               existingContent,
+
+			expectedErr: dao.ErrCommentAlreadyExists,
+		},
+	}
+
+	for _, tt := range flagTestCreateApplicationComment {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			tx := dbtest.Tx(t, db)
+			dbtest.Bootstrap(t, tx)
+			dbtest.LoadFixtures(t, tx, fixturesForComment...)
+
+			// Call the DAO to do the insert
+			commentDAO := dao.NewComment(tx)
+
+			id, err := commentDAO.CreateApplicationComment(ctx, tt.applicationExternalID, tt.content, userEntityID, kind, isBulkAction)
+			assert.ErrorIs(err, tt.expectedErr)
+			assert.Equal(tt.expectedErr == nil, id != 0)
+
+			// a failed statement aborts the tx, nothing can be re-read
+			if tt.expectedErr == dao.ErrCommentAlreadyExists {
+				return
+			}
+
+			// re-read the rows to check what the DAO actually wrote
+			var count int
+			err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM applications.comments WHERE application_id = $1`, applicationID).Scan(&count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(tt.expectedComments, count)
+
+			if tt.expectedErr == nil {
+				var (
+					gotContent, gotUserEntityID, gotKind string
+					gotIsBulkAction                      bool
+					gotLastInteractionDate               time.Time
+				)
+
+				err = tx.QueryRow(
+					ctx,
+					`SELECT c.content, c.user_entity_id, c.kind::TEXT, c.is_bulk_action, a.last_interaction_date
+					FROM applications.comments c
+						INNER JOIN applications.applications a ON a.id = c.application_id
+					WHERE c.id = $1`,
+					id,
+				).Scan(&gotContent, &gotUserEntityID, &gotKind, &gotIsBulkAction, &gotLastInteractionDate)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				assert.Equal(tt.content, gotContent)
+				assert.Equal(userEntityID, gotUserEntityID)
+				assert.Equal(kind, gotKind)
+				assert.Equal(isBulkAction, gotIsBulkAction)
+				// the update ran in the same tx, so the application was touched
+				assert.WithinRange(gotLastInteractionDate, time.Now().Add(-3*time.Second), time.Now().Add(3*time.Second))
+			}
+		})
+	}
+}
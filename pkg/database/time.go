@@ -0,0 +1,34 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// timestamp layouts of the JSON built by postgres, to_jsonb drops the zone of TIMESTAMP columns
+var jsonTimeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"}
+
+// CustomTime is a time read from the JSON aggregates of the queries, as well as from plain columns.
+type CustomTime time.Time
+
+func (t CustomTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(t))
+}
+
+func (t *CustomTime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	var err error
+	for _, layout := range jsonTimeLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			*t = CustomTime(parsed)
+			return nil
+		}
+	}
+
+	return err
+}
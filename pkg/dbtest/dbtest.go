@@ -0,0 +1,144 @@
+package dbtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// MigrationsDir is where Bootstrap looks for the .sql files creating the schema, relative to the tested package.
+// It can be overridden with the DB_MIGRATIONS_DIR env var, and when both are empty the closest migrations folder
+// up from the tested package is used, wherever the package sits in the module.
+var MigrationsDir = ""
+
+// Fixture is a single statement inserting test data.
+type Fixture struct {
+	Query string
+	Args  []any
+}
+
+// Connect opens a pool on DATABASE_URL and skips the test when it is not set.
+func Connect(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set, skipping database test")
+	}
+
+	db, err := pgxpool.New(context.Background(), url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(db.Close)
+
+	return db
+}
+
+// Tx starts a transaction rolled back once the (sub)test is over, so every case starts from a clean DB.
+func Tx(t testing.TB, db *pgxpool.Pool) pgx.Tx {
+	t.Helper()
+
+	ctx := context.Background()
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rollback to clean the DB
+	t.Cleanup(func() {
+		if err := tx.Rollback(ctx); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return tx
+}
+
+// Bootstrap creates the schema inside tx by running the up migrations in order, then the given statements.
+func Bootstrap(t testing.TB, tx pgx.Tx, statements ...string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	dir := MigrationsDir
+	if env := os.Getenv("DB_MIGRATIONS_DIR"); env != "" {
+		dir = env
+	}
+
+	if dir == "" {
+		dir = findMigrationsDir(t)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, ".down.sql") {
+			continue
+		}
+
+		migration, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = tx.Exec(ctx, string(migration)); err != nil {
+			t.Fatalf("failed to run migration %s: %s", path, err)
+		}
+	}
+
+	for _, statement := range statements {
+		if _, err = tx.Exec(ctx, statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// findMigrationsDir returns the closest migrations folder up from the tested package.
+func findMigrationsDir(t testing.TB) string {
+	t.Helper()
+
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for {
+		if info, err := os.Stat(filepath.Join(dir, "migrations")); err == nil && info.IsDir() {
+			return filepath.Join(dir, "migrations")
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			t.Fatal("no migrations folder up from the tested package, set DB_MIGRATIONS_DIR")
+		}
+
+		dir = parent
+	}
+}
+
+// LoadFixtures inserts the fixtures inside tx, in order.
+func LoadFixtures(t testing.TB, tx pgx.Tx, fixtures ...Fixture) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	for _, fixture := range fixtures {
+		if _, err := tx.Exec(ctx, fixture.Query, fixture.Args...); err != nil {
+			t.Fatalf("failed to load fixture %q: %s", fixture.Query, err)
+		}
+	}
+}
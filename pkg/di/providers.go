@@ -0,0 +1,28 @@
+package di
+
+import (
+	"context"
+
+	"github.com/google/wire"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Work4Labs/uservice-applications/pkg/consumers"
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+)
+
+// Beginner opens the transactions shared by the providers, like the database pool of the service.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// ProviderSet builds the dependencies of the consumers from a transaction of the pool.
+var ProviderSet = wire.NewSet(
+	ProvideTx,
+	dao.NewComment,
+	wire.Bind(new(consumers.ApplicationCommentService), new(*dao.Comment)),
+	wire.Bind(new(consumers.Tx), new(pgx.Tx)),
+)
+
+func ProvideTx(ctx context.Context, db Beginner) (pgx.Tx, error) {
+	return db.Begin(ctx)
+}
@@ -0,0 +1,21 @@
+//go:build wireinject
+// +build wireinject
+
+package di
+
+import (
+	"context"
+
+	"github.com/google/wire"
+
+	"github.com/Work4Labs/uservice-applications/pkg/consumers"
+)
+
+func InitCreateApplicationCommentDependencies(ctx context.Context, db Beginner) (*consumers.CreateApplicationCommentDependencies, error) {
+	wire.Build(
+		ProviderSet,
+		wire.Struct(new(consumers.CreateApplicationCommentDependencies), "*"),
+	)
+
+	return nil, nil
+}
@@ -0,0 +1,84 @@
+package di_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/Work4Labs/uservice-applications/pkg/di"
+)
+
+// standInTx satisfies pgx.Tx without a database, the providers only need to hold it.
+type standInTx struct {
+	pgx.Tx
+}
+
+// standInBeginner replaces the database pool the injector is given.
+type standInBeginner struct {
+	tx  pgx.Tx
+	err error
+}
+
+func (b *standInBeginner) Begin(ctx context.Context) (pgx.Tx, error) {
+	return b.tx, b.err
+}
+
+func TestInitCreateApplicationCommentDependencies(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		tx = &standInTx{}
+
+		errBegin = errors.New("failed to begin tx")
+	)
+
+	flagTestInitCreateApplicationCommentDependencies := []struct {
+		name string
+
+		beginner *standInBeginner
+
+		expectedDeps bool
+		expectedErr  error
+	}{
+		{
+			name: "ok",
+
+			beginner: &standInBeginner{tx: tx},
+
+			expectedDeps: true,
+			expectedErr:  nil,
+		},
+		{
+			name: "ko - begin tx",
+
+			beginner: &standInBeginner{err: errBegin},
+
+			expectedDeps: false,
+			expectedErr:  errBegin,
+		},
+	}
+
+	for _, tt := range flagTestInitCreateApplicationCommentDependencies {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			deps, err := di.InitCreateApplicationCommentDependencies(ctx, tt.beginner)
+			assert.ErrorIs(err, tt.expectedErr)
+
+			if tt.expectedDeps {
+				if assert.NotNil(deps) {
+					assert.NotNil(deps.Service)
+					// every provider must share the same transaction
+					assert.Same(tx, deps.Tx)
+				}
+			} else {
+				assert.Nil(deps)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/Work4Labs/uservice-applications/pkg/database"
+)
+
+type Application struct {
+	ID                  int64
+	ExternalID          uuid.UUID
+	OrganizationName    string
+	JobID               string
+	CampaignID          string
+	CandidateID         *string
+	CreatedAt           database.CustomTime
+	UpdatedAt           database.CustomTime
+	LastInteractionDate database.CustomTime
+
+	Answers  []*Answer
+	Comments []*Comment
+	Statuses []*ApplicationStatus
+
+	// filled from the users service, never by the queries
+	Candidate *Candidate `db:"-"`
+}
+
+type Answer struct {
+	ID            int64  `json:"id"`
+	ApplicationID int64  `json:"application_id"`
+	QuestionLabel string `json:"question_label"`
+	Answer        string `json:"answer"`
+}
+
+type Comment struct {
+	ID            int64               `json:"id"`
+	ApplicationID int64               `json:"application_id"`
+	Content       string              `json:"content"`
+	UserEntityID  string              `json:"user_entity_id"`
+	Kind          string              `json:"kind"`
+	IsBulkAction  bool                `json:"is_bulk_action"`
+	CreatedAt     database.CustomTime `json:"created_at"`
+}
+
+type Status struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+}
+
+type ApplicationStatus struct {
+	ID            string              `json:"id"`
+	ApplicationID int64               `json:"application_id"`
+	StatusID      int64               `json:"status_id"`
+	UserEntityID  string              `json:"user_entity_id"`
+	IsBulkAction  bool                `json:"is_bulk_action"`
+	CreatedAt     database.CustomTime `json:"created_at"`
+	Status        *Status             `json:"status"`
+}
+
+type Candidate struct {
+	ID               string
+	Email            string
+	EmailConstraint  string
+	EmailVerified    bool
+	Enabled          bool
+	FirstName        string
+	LastName         string
+	RealmID          string
+	Username         string
+	CreatedTimestamp *int64
+}
+
+type AnswerCreation struct {
+	QuestionLabel string
+	Answer        string
+}
+
+type UTMParameters struct {
+	Label string
+	Value string
+}
+
+type ApplicationCreation struct {
+	Answers          []AnswerCreation
+	CampaignID       string
+	CandidateID      string
+	JobID            strfmt.UUID
+	OrganizationName string
+	UTMParameters    []UTMParameters
+}
@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TaskStatus string
+
+const (
+	TaskStatusPending    TaskStatus = "PENDING"
+	TaskStatusRunning    TaskStatus = "RUNNING"
+	TaskStatusDone       TaskStatus = "DONE"
+	TaskStatusFailed     TaskStatus = "FAILED"
+	TaskStatusCancelled  TaskStatus = "CANCELLED"
+	TaskStatusDuplicated TaskStatus = "DUPLICATED"
+)
+
+const taskNameSeparator = "::"
+
+var ErrInvalidTaskName = errors.New("invalid task name")
+
+type Task struct {
+	ID           int64
+	Name         string
+	Status       TaskStatus
+	UniqueParams string
+	// when the task is allowed to run
+	Plan       pgtype.Range[pgtype.Timestamptz]
+	WorkerUUID *uuid.UUID
+}
+
+// MarshalTaskName builds the name of a task from the workflow it belongs to.
+func MarshalTaskName(workflowName, taskName string) string {
+	return workflowName + taskNameSeparator + taskName
+}
+
+// UnmarshalTaskName splits a task name built by MarshalTaskName into its workflow and task names.
+func UnmarshalTaskName(name string) (string, string, error) {
+	workflowName, taskName, ok := strings.Cut(name, taskNameSeparator)
+	if !ok || workflowName == "" || taskName == "" {
+		return "", "", fmt.Errorf("task name %q: %w", name, ErrInvalidTaskName)
+	}
+
+	return workflowName, taskName, nil
+}
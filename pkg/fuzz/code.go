@@ -0,0 +1,26 @@
+package fuzz
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const taskNameSeparator = "::"
+
+var ErrInvalidTaskName = errors.New("invalid task name")
+
+// MarshalTaskName builds the name of a task from the workflow it belongs to.
+func MarshalTaskName(workflowName, taskName string) string {
+	return workflowName + taskNameSeparator + taskName
+}
+
+// UnmarshalTaskName splits a task name built by MarshalTaskName into its workflow and task names.
+func UnmarshalTaskName(name string) (string, string, error) {
+	workflowName, taskName, ok := strings.Cut(name, taskNameSeparator)
+	if !ok || workflowName == "" || taskName == "" {
+		return "", "", fmt.Errorf("task name %q: %w", name, ErrInvalidTaskName)
+	}
+
+	return workflowName, taskName, nil
+}
@@ -0,0 +1,48 @@
+package fuzz_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Work4Labs/uservice-applications/pkg/fuzz"
+)
+
+// Invariants checked for every input:
+//   - UnmarshalTaskName never panics.
+//   - it either fails with ErrInvalidTaskName and empty names, or returns two non-empty names.
+//   - round-trip: MarshalTaskName of the names it returns gives back the input.
+func FuzzUnmarshalTaskName(f *testing.F) {
+	// seed corpus: the inputs of the TestUnmarshalTaskName cases
+	for _, seed := range []string{
+		"workflow::task",
+		"workflow::task::step",
+		"workflow",
+		"::task",
+		"",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		workflowName, taskName, err := fuzz.UnmarshalTaskName(name)
+		if err != nil {
+			if !errors.Is(err, fuzz.ErrInvalidTaskName) {
+				t.Fatalf("unexpected error for %q: %s", name, err)
+			}
+
+			if workflowName != "" || taskName != "" {
+				t.Fatalf("names returned along an error for %q", name)
+			}
+
+			return
+		}
+
+		if workflowName == "" || taskName == "" {
+			t.Fatalf("empty name returned without error for %q", name)
+		}
+
+		if roundTrip := fuzz.MarshalTaskName(workflowName, taskName); roundTrip != name {
+			t.Fatalf("round-trip of %q gave %q", name, roundTrip)
+		}
+	})
+}
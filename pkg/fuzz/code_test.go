@@ -0,0 +1,72 @@
+package fuzz_test
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/Work4Labs/uservice-applications/pkg/fuzz"
+)
+
+func TestUnmarshalTaskName(t *testing.T) {
+	flagTestUnmarshalTaskName := []struct {
+		name string
+
+		taskName string
+
+		expectedWorkflowName string
+		expectedTaskName     string
+		expectedErr          error
+	}{
+		{
+			name: "ok",
+
+			taskName: "workflow::task",
+
+			expectedWorkflowName: "workflow",
+			expectedTaskName:     "task",
+		},
+		{
+			name: "ok - separator in task name",
+
+			taskName: "workflow::task::step",
+
+			expectedWorkflowName: "workflow",
+			expectedTaskName:     "task::step",
+		},
+		{
+			name: "ko - no separator",
+
+			taskName: "workflow",
+
+			expectedErr: fuzz.ErrInvalidTaskName,
+		},
+		{
+			name: "ko - empty workflow",
+
+			taskName: "::task",
+
+			expectedErr: fuzz.ErrInvalidTaskName,
+		},
+		{
+			name: "ko - empty",
+
+			taskName: "",
+
+			expectedErr: fuzz.ErrInvalidTaskName,
+		},
+	}
+
+	for _, tt := range flagTestUnmarshalTaskName {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			workflowName, taskName, err := fuzz.UnmarshalTaskName(tt.taskName)
+			assert.ErrorIs(err, tt.expectedErr)
+			assert.Equal(tt.expectedWorkflowName, workflowName)
+			assert.Equal(tt.expectedTaskName, taskName)
+		})
+	}
+}
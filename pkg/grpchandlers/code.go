@@ -0,0 +1,104 @@
+package grpchandlers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Work4Labs/go_framework/db"
+	"github.com/Work4Labs/go_framework/helpers"
+
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/services"
+	"github.com/Work4Labs/uservice-applications/proto/applicationspb"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const requestIDHeader = "x-request-id"
+
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+type ApplicationCommentService interface {
+	CreateApplicationComment(ctx context.Context, applicationExternalID, content, userEntityID, kind string, isBulkAction bool) (int64, error)
+}
+
+type CreateApplicationCommentDependencies struct {
+	Service ApplicationCommentService
+	Tx      Tx
+}
+
+type CreateApplicationCommentServiceFactory func(ctx context.Context) (*CreateApplicationCommentDependencies, error)
+
+type Applications struct {
+	applicationspb.UnimplementedApplicationsServer
+
+	ServiceFactory CreateApplicationCommentServiceFactory
+}
+
+func NewApplications() *Applications {
+	return &Applications{ServiceFactory: InitCreateApplicationCommentDependencies}
+}
+
+func (s *Applications) CreateApplicationComment(ctx context.Context, req *applicationspb.CreateApplicationCommentRequest) (*applicationspb.CreateApplicationCommentResponse, error) {
+	ctx, logger := helpers.ContextWithLog(
+		ctx,
+		log.Fields{"request_id": requestIDFromMetadata(ctx), "application_id": req.GetApplicationId()},
+	)
+
+	if req.GetApplicationId() == "" || req.GetUserEntityId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "application_id and user_entity_id are required")
+	}
+
+	deps, err := s.ServiceFactory(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to create dependencies for create application comment server")
+		return nil, status.Error(codes.Internal, "failed to create application comment")
+	}
+
+	defer func() {
+		db.CleanTx(ctx, deps.Tx, err)
+	}()
+
+	commentID, err := deps.Service.CreateApplicationComment(
+		ctx,
+		req.GetApplicationId(),
+		req.GetContent(),
+		req.GetUserEntityId(),
+		req.GetKind(),
+		req.GetIsBulkAction(),
+	)
+
+	switch {
+	case errors.Is(err, services.ErrInvalidCommentKind):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, dao.ErrApplicationNotFound):
+		return nil, status.Error(codes.NotFound, "application not found")
+	case errors.Is(err, dao.ErrCommentAlreadyExists):
+		return nil, status.Error(codes.AlreadyExists, "comment already exists")
+	case err != nil:
+		logger.WithError(err).Error("failed to handle creating application comment")
+		return nil, status.Error(codes.Internal, "failed to create application comment")
+	}
+
+	return &applicationspb.CreateApplicationCommentResponse{Id: commentID}, nil
+}
+
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(requestIDHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
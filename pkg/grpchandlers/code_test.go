@@ -0,0 +1,213 @@
+package grpchandlers_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/samber/lo"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/grpchandlers"
+	"github.com/Work4Labs/uservice-applications/pkg/grpchandlers/mocks"
+	"github.com/Work4Labs/uservice-applications/pkg/services"
+	"github.com/Work4Labs/uservice-applications/proto/applicationspb"
+)
+
+// startServer serves the server in-process on a bufconn listener and returns a client connected to it.
+func startServer(t *testing.T, server applicationspb.ApplicationsServer) applicationspb.ApplicationsClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer()
+	applicationspb.RegisterApplicationsServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+	})
+
+	return applicationspb.NewApplicationsClient(conn)
+}
+
+func TestCreateApplicationComment(t *testing.T) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "request_id")
+
+	var (
+		validRequest = &applicationspb.CreateApplicationCommentRequest{
+			ApplicationId: "00000000-0000-0000-0000-000000000010",
+			Content:       "content",
+			UserEntityId:  "00000000-0000-0000-0000-000000000011",
+			Kind:          "COMMENT",
+			IsBulkAction:  false,
+		}
+
+		errService = errors.New("fail during service")
+	)
+
+	flagTestCreateApplicationComment := []struct {
+		name string
+
+		depsErr error
+
+		request *applicationspb.CreateApplicationCommentRequest
+
+		serviceCalled bool
+		serviceRes    int64
+		serviceErr    error
+
+		expectedRes  *applicationspb.CreateApplicationCommentResponse
+		expectedCode codes.Code
+
+		commitCalled *bool
+	}{
+		{
+			name: "ok",
+
+			request: validRequest,
+
+			serviceCalled: true,
+			serviceRes:    1,
+
+			expectedRes:  &applicationspb.CreateApplicationCommentResponse{Id: 1},
+			expectedCode: codes.OK,
+
+			commitCalled: lo.ToPtr(true),
+		},
+		{
+			name: "ko - missing application id",
+
+			request: &applicationspb.CreateApplicationCommentRequest{
+				Content:      "content",
+				UserEntityId: "00000000-0000-0000-0000-000000000011",
+				Kind:         "COMMENT",
+			},
+
+			expectedCode: codes.InvalidArgument,
+			commitCalled: nil,
+		},
+		{
+			name: "ko - init service",
+
+			depsErr: errors.New("failed to init service"),
+
+			request: validRequest,
+
+			expectedCode: codes.Internal,
+			commitCalled: nil,
+		},
+		{
+			name: "ko - invalid kind",
+
+			request: validRequest,
+
+			serviceCalled: true,
+			serviceErr:    services.ErrInvalidCommentKind,
+
+			expectedCode: codes.InvalidArgument,
+			commitCalled: lo.ToPtr(false),
+		},
+		{
+			name: "ko - application not found",
+
+			request: validRequest,
+
+			serviceCalled: true,
+			serviceErr:    dao.ErrApplicationNotFound,
+
+			expectedCode: codes.NotFound,
+			commitCalled: lo.ToPtr(false),
+		},
+		{
+			name: "ko - service error",
+
+			request: validRequest,
+
+			serviceCalled: true,
+			serviceErr:    errService,
+
+			expectedCode: codes.Internal,
+			commitCalled: lo.ToPtr(false),
+		},
+	}
+
+	for _, tt := range flagTestCreateApplicationComment {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			service := &mocks.ApplicationCommentService{}
+			service.On(
+				"CreateApplicationComment",
+				mock.Anything,
+				tt.request.GetApplicationId(),
+				tt.request.GetContent(),
+				tt.request.GetUserEntityId(),
+				tt.request.GetKind(),
+				tt.request.GetIsBulkAction(),
+			).Return(tt.serviceRes, tt.serviceErr)
+
+			tx := &mocks.Tx{}
+			tx.On("Commit", mock.Anything).Return(nil)
+			tx.On("Rollback", mock.Anything).Return(nil)
+
+			client := startServer(t, &grpchandlers.Applications{
+				ServiceFactory: func(ctx context.Context) (*grpchandlers.CreateApplicationCommentDependencies, error) {
+					return &grpchandlers.CreateApplicationCommentDependencies{
+						Service: service,
+						Tx:      tx,
+					}, tt.depsErr
+				},
+			})
+
+			res, err := client.CreateApplicationComment(ctx, tt.request)
+			assert.Equal(tt.expectedCode, status.Code(err))
+
+			if tt.expectedRes != nil {
+				assert.Equal(tt.expectedRes.GetId(), res.GetId())
+			} else {
+				assert.Nil(res)
+			}
+
+			if tt.serviceCalled {
+				service.AssertExpectations(t)
+			} else {
+				service.AssertNotCalled(t, "CreateApplicationComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			if tt.commitCalled != nil {
+				if *tt.commitCalled {
+					tx.AssertCalled(t, "Commit", mock.Anything)
+				} else {
+					tx.AssertCalled(t, "Rollback", mock.Anything)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package grpchandlers
+
+import (
+	"context"
+	"errors"
+)
+
+// in the services, wire generates the injectors from their providers, see pkg/di. The examples only need their signatures.
+var errNotInjected = errors.New("dependencies are not injected in the examples")
+
+func InitCreateApplicationCommentDependencies(ctx context.Context) (*CreateApplicationCommentDependencies, error) {
+	return nil, errNotInjected
+}
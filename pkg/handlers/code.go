@@ -18,6 +18,20 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+type CreateApplicationService interface {
+	CreateApplication(ctx context.Context, application *entities.ApplicationCreation, principal *keycloak.JWTUser) (*entities.Application, error)
+}
+
+type CreateApplicationDependencies struct {
+	Service CreateApplicationService
+	Tx      Tx
+}
+
 type CreateApplicationServiceFactory func(ctx context.Context) (*CreateApplicationDependencies, error)
 
 type CreateApplication struct {
@@ -57,6 +71,18 @@ func (h *CreateApplication) Handle(params applications.CreateApplicationByJobIDP
 		})
 	}
 
+	if params.UtmMedium != nil {
+		applicationCreation.UTMParameters = append(applicationCreation.UTMParameters, entities.UTMParameters{
+			Label: "utm_medium", Value: *params.UtmMedium,
+		})
+	}
+
+	if params.UtmSource != nil {
+		applicationCreation.UTMParameters = append(applicationCreation.UTMParameters, entities.UTMParameters{
+			Label: "utm_source", Value: *params.UtmSource,
+		})
+	}
+
 	application, err := deps.Service.CreateApplication(ctx, applicationCreation, principal)
 	if err != nil {
 		logger.WithError(err).Error("failed to handle creating application")
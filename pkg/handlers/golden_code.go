@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/samber/lo"
+
+	"github.com/Work4Labs/go_framework/db"
+	"github.com/Work4Labs/go_framework/helpers"
+	"github.com/Work4Labs/go_framework/sdk/keycloak"
+
+	"github.com/Work4Labs/uservice-applications/models"
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+	"github.com/Work4Labs/uservice-applications/restapi/operations/applications"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type GetApplicationService interface {
+	GetApplication(ctx context.Context, id string, principal *keycloak.JWTUser) (*entities.Application, error)
+}
+
+type GetApplicationDependencies struct {
+	Service GetApplicationService
+	Tx      Tx
+}
+
+type GetApplicationServiceFactory func(ctx context.Context) (*GetApplicationDependencies, error)
+
+type GetApplication struct {
+	ServiceFactory GetApplicationServiceFactory
+}
+
+func NewGetApplication() *GetApplication {
+	return &GetApplication{ServiceFactory: InitGetApplicationDependencies}
+}
+
+func (h *GetApplication) Handle(params applications.GetApplicationParams, principal *keycloak.JWTUser) middleware.Responder {
+	requestID := lo.FromPtr(params.RequestID)
+	ctx, logger := helpers.ContextWithLog(
+		params.HTTPRequest.Context(),
+		log.Fields{"request_id": requestID, "user_groups": principal.Groups},
+	)
+
+	deps, err := h.ServiceFactory(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to create dependencies for get application handler")
+		return applications.NewGetApplicationInternalServerError().WithPayload(models.ApplicationsError("failed to get application"))
+	}
+
+	defer func() {
+		db.CleanTx(ctx, deps.Tx, err)
+	}()
+
+	application, err := deps.Service.GetApplication(ctx, params.ApplicationID, principal)
+	if errors.Is(err, dao.ErrApplicationNotFound) {
+		return applications.NewGetApplicationNotFound().WithPayload(models.ApplicationsError("application not found"))
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to handle getting application")
+		return applications.NewGetApplicationInternalServerError().WithPayload(models.ApplicationsError("failed to get application"))
+	}
+
+	return applications.NewGetApplicationOK().WithPayload(toApplicationDetails(application))
+}
+
+func toApplicationDetails(application *entities.Application) *models.ApplicationDetails {
+	return &models.ApplicationDetails{
+		ID:               application.ExternalID.String(),
+		JobID:            application.JobID,
+		OrganizationName: application.OrganizationName,
+		CampaignID:       application.CampaignID,
+		CandidateID:      lo.FromPtr(application.CandidateID),
+		Answers: lo.Map(application.Answers, func(answer *entities.Answer, _ int) *models.ApplicationAnswer {
+			return &models.ApplicationAnswer{
+				QuestionLabel: answer.QuestionLabel,
+				Answer:        answer.Answer,
+			}
+		}),
+		Comments: lo.Map(application.Comments, func(comment *entities.Comment, _ int) *models.ApplicationComment {
+			return &models.ApplicationComment{
+				Content:      comment.Content,
+				Kind:         comment.Kind,
+				UserEntityID: comment.UserEntityID,
+				IsBulkAction: comment.IsBulkAction,
+				CreatedAt:    strfmt.DateTime(time.Time(comment.CreatedAt)),
+			}
+		}),
+		Statuses: lo.Map(application.Statuses, func(status *entities.ApplicationStatus, _ int) *models.ApplicationStatus {
+			return &models.ApplicationStatus{
+				ID:           status.ID,
+				Label:        status.Status.Label,
+				UserEntityID: status.UserEntityID,
+				IsBulkAction: status.IsBulkAction,
+				CreatedAt:    strfmt.DateTime(time.Time(status.CreatedAt)),
+			}
+		}),
+	}
+}
@@ -0,0 +1,152 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Work4Labs/go_framework/sdk/keycloak"
+
+	"github.com/Work4Labs/uservice-applications/pkg/dao"
+	"github.com/Work4Labs/uservice-applications/pkg/database"
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+	"github.com/Work4Labs/uservice-applications/pkg/handlers"
+	"github.com/Work4Labs/uservice-applications/pkg/handlers/mocks"
+	"github.com/Work4Labs/uservice-applications/restapi/operations/applications"
+)
+
+func TestGetApplication(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		user = &keycloak.JWTUser{
+			IsAuthenticated: true,
+			JWT:             "user-token",
+		}
+
+		createdAt = time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC)
+
+		requestID = "request_id"
+		params    = applications.GetApplicationParams{
+			HTTPRequest:   (&http.Request{}).WithContext(ctx),
+			RequestID:     &requestID,
+			ApplicationID: "00000000-0000-0000-0000-000000000113",
+		}
+
+		application = &entities.Application{
+			ExternalID:       uuid.MustParse("00000000-0000-0000-0000-000000000113"),
+			JobID:            "00000000-0000-0000-0000-000000000013",
+			OrganizationName: "seiza",
+			CampaignID:       "00000000-0000-0000-0000-000000000011",
+			CandidateID:      lo.ToPtr("00000000-0000-0000-0000-000000000012"),
+			Answers: []*entities.Answer{
+				{QuestionLabel: "question one", Answer: "oui"},
+				{QuestionLabel: "question two", Answer: "non"},
+			},
+			Comments: []*entities.Comment{
+				{
+					Content:      "this is a comment",
+					Kind:         "COMMENT",
+					UserEntityID: "00000000-0000-0000-0000-000000000014",
+					IsBulkAction: false,
+					CreatedAt:    database.CustomTime(createdAt),
+				},
+			},
+			Statuses: []*entities.ApplicationStatus{
+				{
+					ID:           "00000000-0000-0000-0000-000000000015",
+					UserEntityID: "00000000-0000-0000-0000-000000000014",
+					IsBulkAction: true,
+					Status:       &entities.Status{Label: "hired"},
+					CreatedAt:    database.CustomTime(createdAt),
+				},
+			},
+		}
+	)
+
+	flagTestGetApplication := []struct {
+		name string
+
+		depsErr error
+
+		serviceRes *entities.Application
+		serviceErr error
+
+		golden string
+
+		commitCalled *bool
+	}{
+		{
+			name: "ok",
+
+			serviceRes: application,
+
+			golden:       "get_application_ok",
+			commitCalled: lo.ToPtr(true),
+		},
+		{
+			name: "ko - init service",
+
+			depsErr: errors.New("failed to init service"),
+
+			golden:       "get_application_internal_error",
+			commitCalled: nil,
+		},
+		{
+			name: "ko - not found",
+
+			serviceErr: dao.ErrApplicationNotFound,
+
+			golden:       "get_application_not_found",
+			commitCalled: lo.ToPtr(false),
+		},
+		{
+			name: "ko - service error",
+
+			serviceErr: errors.New("fail during service"),
+
+			golden:       "get_application_internal_error",
+			commitCalled: lo.ToPtr(false),
+		},
+	}
+
+	for _, tt := range flagTestGetApplication {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mocks.GetApplicationService{}
+			service.On("GetApplication", mock.Anything, params.ApplicationID, user).Return(tt.serviceRes, tt.serviceErr)
+
+			tx := &mocks.Tx{}
+			tx.On("Commit", mock.Anything).Return(nil)
+			tx.On("Rollback", mock.Anything).Return(nil)
+
+			handler := &handlers.GetApplication{
+				ServiceFactory: func(ctx context.Context) (*handlers.GetApplicationDependencies, error) {
+					return &handlers.GetApplicationDependencies{
+						Service: service,
+						Tx:      tx,
+					}, tt.depsErr
+				},
+			}
+
+			res := handler.Handle(params, user)
+			assertGolden(t, tt.golden, res)
+
+			if tt.commitCalled != nil {
+				if *tt.commitCalled {
+					tx.AssertCalled(t, "Commit", mock.Anything)
+					service.AssertExpectations(t)
+				} else {
+					tx.AssertCalled(t, "Rollback", mock.Anything)
+				}
+			}
+		})
+	}
+}
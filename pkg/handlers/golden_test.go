@@ -0,0 +1,55 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	tassert "github.com/stretchr/testify/assert"
+)
+
+// run `go test ./pkg/handlers -update`, on the packages having golden tests only, to rewrite the golden files after a
+// deliberate change of the payloads
+var update = flag.Bool("update", false, "update the golden files")
+
+// assertGolden writes the responder through a recorder, and compares its status and JSON body to testdata/<golden>.golden
+func assertGolden(t *testing.T, golden string, res middleware.Responder) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	res.WriteResponse(rec, runtime.JSONProducer())
+
+	var body interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := json.MarshalIndent(map[string]interface{}{"status": rec.Code, "body": body}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join("testdata", golden+".golden")
+
+	if *update {
+		if err = os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = os.WriteFile(path, append(got, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file, run the tests with -update to create it: %s", err)
+	}
+
+	tassert.JSONEq(t, string(want), string(got))
+}
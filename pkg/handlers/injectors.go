@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+)
+
+// in the services, wire generates the injectors from their providers, see pkg/di. The examples only need their signatures.
+var errNotInjected = errors.New("dependencies are not injected in the examples")
+
+func InitCreateApplicationDependencies(ctx context.Context) (*CreateApplicationDependencies, error) {
+	return nil, errNotInjected
+}
+
+func InitGetApplicationDependencies(ctx context.Context) (*GetApplicationDependencies, error) {
+	return nil, errNotInjected
+}
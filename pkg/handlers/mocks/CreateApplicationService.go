@@ -0,0 +1,62 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/Work4Labs/uservice-applications/pkg/entities"
+
+	keycloak "github.com/Work4Labs/go_framework/sdk/keycloak"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CreateApplicationService is an autogenerated mock type for the CreateApplicationService type
+type CreateApplicationService struct {
+	mock.Mock
+}
+
+// CreateApplication provides a mock function with given fields: ctx, application, principal
+func (_m *CreateApplicationService) CreateApplication(ctx context.Context, application *entities.ApplicationCreation, principal *keycloak.JWTUser) (*entities.Application, error) {
+	ret := _m.Called(ctx, application, principal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApplication")
+	}
+
+	var r0 *entities.Application
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ApplicationCreation, *keycloak.JWTUser) (*entities.Application, error)); ok {
+		return rf(ctx, application, principal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ApplicationCreation, *keycloak.JWTUser) *entities.Application); ok {
+		r0 = rf(ctx, application, principal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Application)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.ApplicationCreation, *keycloak.JWTUser) error); ok {
+		r1 = rf(ctx, application, principal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCreateApplicationService creates a new instance of CreateApplicationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCreateApplicationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CreateApplicationService {
+	mock := &CreateApplicationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,76 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/samber/lo"
+
+	"github.com/Work4Labs/go_framework/sdk/keycloak"
+
+	"github.com/Work4Labs/uservice-applications/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type TokenParser interface {
+	ParseToken(ctx context.Context, token string) (*keycloak.JWTUser, error)
+}
+
+type principalKey struct{}
+
+type Auth struct {
+	Parser        TokenParser
+	AllowedGroups []string
+}
+
+func NewAuth(parser TokenParser, allowedGroups ...string) *Auth {
+	return &Auth{
+		Parser:        parser,
+		AllowedGroups: allowedGroups,
+	}
+}
+
+// PrincipalFromContext returns the user authenticated by the Auth middleware, nil if there is none.
+func PrincipalFromContext(ctx context.Context) *keycloak.JWTUser {
+	principal, _ := ctx.Value(principalKey{}).(*keycloak.JWTUser)
+	return principal
+}
+
+// Handler authenticates the bearer token of the request and checks the user belongs to one of the allowed groups
+// before calling next with the principal in the request context.
+func (a *Auth) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			errorResponder(http.StatusUnauthorized, "missing bearer token").WriteResponse(w, runtime.JSONProducer())
+			return
+		}
+
+		principal, err := a.Parser.ParseToken(r.Context(), token)
+		if err != nil || principal == nil || !principal.IsAuthenticated {
+			log.WithError(err).Warn("failed to authenticate request")
+			errorResponder(http.StatusUnauthorized, "invalid token").WriteResponse(w, runtime.JSONProducer())
+			return
+		}
+
+		if len(a.AllowedGroups) > 0 && !lo.Some(principal.Groups, a.AllowedGroups) {
+			errorResponder(http.StatusForbidden, "user is not allowed to access this resource").WriteResponse(w, runtime.JSONProducer())
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal)))
+	})
+}
+
+func errorResponder(code int, message string) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(code)
+
+		_ = producer.Produce(rw, models.ApplicationsError(message))
+	})
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	keycloak "github.com/Work4Labs/go_framework/sdk/keycloak"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenParser is an autogenerated mock type for the TokenParser type
+type TokenParser struct {
+	mock.Mock
+}
+
+// ParseToken provides a mock function with given fields: ctx, token
+func (_m *TokenParser) ParseToken(ctx context.Context, token string) (*keycloak.JWTUser, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseToken")
+	}
+
+	var r0 *keycloak.JWTUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*keycloak.JWTUser, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *keycloak.JWTUser); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloak.JWTUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTokenParser creates a new instance of TokenParser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenParser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenParser {
+	mock := &TokenParser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
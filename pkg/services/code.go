@@ -2,12 +2,20 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	"github.com/Work4Labs/go_framework/sdk/keycloak"
 	"github.com/Work4Labs/uservice-applications/restapi/operations/applications"
 )
 
+var ErrInvalidCommentKind = errors.New("invalid comment kind")
+
+// the values of the comment_kind enum
+var allowedCommentKinds = []string{"COMMENT", "INTERVIEW_CANCELLED"}
+
 type ApplicationCommentCreator interface {
 	CreateApplicationComment(ctx context.Context, applicationExternalID, content, userEntityID, kind string, isBulkAction bool) (int64, error)
 }
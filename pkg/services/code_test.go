@@ -10,6 +10,7 @@ import (
 	"github.com/Work4Labs/uservice-applications/pkg/services"
 	"github.com/Work4Labs/uservice-applications/pkg/services/mocks"
 	"github.com/Work4Labs/uservice-applications/restapi/operations/applications"
+	tassert "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -28,8 +29,9 @@ func TestApplicationCreateCommentService(t *testing.T) {
 
 		params applications.CreateApplicationCommentParams
 
-		resDao int64
-		errDao error
+		daoCalled bool
+		resDao    int64
+		errDao    error
 
 		expectedErr error
 	}{
@@ -45,7 +47,8 @@ func TestApplicationCreateCommentService(t *testing.T) {
 				},
 			},
 
-			resDao: 1,
+			daoCalled: true,
+			resDao:    1,
 		},
 		{
 			name: "err invalid kind",
@@ -73,8 +76,9 @@ func TestApplicationCreateCommentService(t *testing.T) {
 				},
 			},
 
-			resDao: 0,
-			errDao: errDAO,
+			daoCalled: true,
+			resDao:    0,
+			errDao:    errDAO,
 
 			expectedErr: errDAO,
 		},
@@ -102,7 +106,12 @@ func TestApplicationCreateCommentService(t *testing.T) {
 			err := service.CreateApplicationComment(ctx, tt.params, user)
 
 			assert.ErrorIs(err, tt.expectedErr)
-			commentDAO.AssertExpectations(t)
+
+			if tt.daoCalled {
+				commentDAO.AssertExpectations(t)
+			} else {
+				commentDAO.AssertNotCalled(t, "CreateApplicationComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
 		})
 	}
 }
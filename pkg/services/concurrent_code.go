@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+)
+
+const maxConcurrentApplicationFetches = 10
+
+type ApplicationGetter interface {
+	GetApplication(ctx context.Context, id string, organizations []string) (*entities.Application, error)
+}
+
+type ApplicationsGetterService struct {
+	applicationDAO ApplicationGetter
+}
+
+func NewApplicationsGetterService(applicationDAO ApplicationGetter) *ApplicationsGetterService {
+	return &ApplicationsGetterService{
+		applicationDAO: applicationDAO,
+	}
+}
+
+// GetApplications fetches the applications concurrently and returns them in the order of ids.
+// The first failing fetch cancels the ones still running.
+func (s *ApplicationsGetterService) GetApplications(ctx context.Context, ids []string, organizations []string) ([]*entities.Application, error) {
+	applications := make([]*entities.Application, len(ids))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentApplicationFetches)
+
+	for i, id := range ids {
+		i, id := i, id
+
+		g.Go(func() error {
+			application, err := s.applicationDAO.GetApplication(ctx, id, organizations)
+			if err != nil {
+				return fmt.Errorf("get application '%s': %w", id, err)
+			}
+
+			// every goroutine writes its own index, so no lock is needed
+			applications[i] = application
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
@@ -0,0 +1,134 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+	"github.com/Work4Labs/uservice-applications/pkg/services"
+	"github.com/Work4Labs/uservice-applications/pkg/services/mocks"
+)
+
+func TestApplicationsGetterService(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		firstID  = "00000000-0000-0000-0000-000000000010"
+		secondID = "00000000-0000-0000-0000-000000000020"
+		thirdID  = "00000000-0000-0000-0000-000000000030"
+	)
+
+	var (
+		organizations = []string{"seiza"}
+
+		applicationsByID = map[string]*entities.Application{
+			firstID:  {ExternalID: uuid.MustParse(firstID), OrganizationName: "seiza"},
+			secondID: {ExternalID: uuid.MustParse(secondID), OrganizationName: "seiza"},
+			thirdID:  {ExternalID: uuid.MustParse(thirdID), OrganizationName: "seiza"},
+		}
+
+		errDAO = errors.New("failed to get application")
+	)
+
+	flagTestGetApplications := []struct {
+		name string
+
+		ids []string
+
+		// delays make the fetches complete in another order than ids
+		daoDelays map[string]time.Duration
+		daoErrs   map[string]error
+		// fetches blocking until the group cancels them, they may not even start
+		cancelled map[string]bool
+
+		expectedRes []*entities.Application
+		expectedErr error
+	}{
+		{
+			name: "ok",
+
+			ids: []string{firstID, secondID, thirdID},
+
+			daoDelays: map[string]time.Duration{
+				firstID:  30 * time.Millisecond,
+				secondID: 20 * time.Millisecond,
+				thirdID:  0,
+			},
+
+			expectedRes: []*entities.Application{applicationsByID[firstID], applicationsByID[secondID], applicationsByID[thirdID]},
+			expectedErr: nil,
+		},
+		{
+			name: "ok - no ids",
+
+			ids: []string{},
+
+			expectedRes: []*entities.Application{},
+			expectedErr: nil,
+		},
+		{
+			name: "ko - first error cancels the other fetches",
+
+			ids: []string{firstID, secondID, thirdID},
+
+			daoErrs: map[string]error{
+				secondID: errDAO,
+			},
+			cancelled: map[string]bool{
+				firstID: true,
+				thirdID: true,
+			},
+
+			expectedRes: nil,
+			expectedErr: errDAO,
+		},
+	}
+
+	for _, tt := range flagTestGetApplications {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			// expectations are matched on arguments, never on call order
+			applicationDAO := &mocks.ApplicationGetter{}
+			for _, id := range tt.ids {
+				id := id
+
+				if tt.cancelled[id] {
+					applicationDAO.On("GetApplication", mock.Anything, id, organizations).
+						Run(func(args mock.Arguments) {
+							select {
+							case <-args.Get(0).(context.Context).Done():
+							case <-time.After(time.Second):
+								// Errorf is safe from any goroutine, Fatal is not
+								t.Errorf("fetch of '%s' was not cancelled", id)
+							}
+						}).
+						Return(nil, context.Canceled).
+						Maybe()
+
+					continue
+				}
+
+				applicationDAO.On("GetApplication", mock.Anything, id, organizations).
+					After(tt.daoDelays[id]).
+					Return(applicationsByID[id], tt.daoErrs[id])
+			}
+
+			service := services.NewApplicationsGetterService(applicationDAO)
+
+			res, err := service.GetApplications(ctx, tt.ids, organizations)
+
+			assert.ErrorIs(err, tt.expectedErr)
+			assert.Equal(tt.expectedRes, res)
+			applicationDAO.AssertExpectations(t)
+		})
+	}
+}
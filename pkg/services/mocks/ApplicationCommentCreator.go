@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationCommentCreator is an autogenerated mock type for the ApplicationCommentCreator type
+type ApplicationCommentCreator struct {
+	mock.Mock
+}
+
+// CreateApplicationComment provides a mock function with given fields: ctx, applicationExternalID, content, userEntityID, kind, isBulkAction
+func (_m *ApplicationCommentCreator) CreateApplicationComment(ctx context.Context, applicationExternalID string, content string, userEntityID string, kind string, isBulkAction bool) (int64, error) {
+	ret := _m.Called(ctx, applicationExternalID, content, userEntityID, kind, isBulkAction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApplicationComment")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool) (int64, error)); ok {
+		return rf(ctx, applicationExternalID, content, userEntityID, kind, isBulkAction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool) int64); ok {
+		r0 = rf(ctx, applicationExternalID, content, userEntityID, kind, isBulkAction)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, bool) error); ok {
+		r1 = rf(ctx, applicationExternalID, content, userEntityID, kind, isBulkAction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewApplicationCommentCreator creates a new instance of ApplicationCommentCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApplicationCommentCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApplicationCommentCreator {
+	mock := &ApplicationCommentCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/Work4Labs/uservice-applications/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationGetter is an autogenerated mock type for the ApplicationGetter type
+type ApplicationGetter struct {
+	mock.Mock
+}
+
+// GetApplication provides a mock function with given fields: ctx, id, organizations
+func (_m *ApplicationGetter) GetApplication(ctx context.Context, id string, organizations []string) (*entities.Application, error) {
+	ret := _m.Called(ctx, id, organizations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplication")
+	}
+
+	var r0 *entities.Application
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*entities.Application, error)); ok {
+		return rf(ctx, id, organizations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *entities.Application); ok {
+		r0 = rf(ctx, id, organizations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Application)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, id, organizations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewApplicationGetter creates a new instance of ApplicationGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApplicationGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApplicationGetter {
+	mock := &ApplicationGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
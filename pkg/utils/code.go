@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+)
+
+// NormalizeOrganizations trims, lower-cases and de-duplicates organization names, keeping their order.
+// It returns nil when no organization is left, so callers can use it as an optional SQL filter.
+func NormalizeOrganizations(organizations []string) []string {
+	var normalized []string
+
+	seen := make(map[string]struct{}, len(organizations))
+	for _, organization := range organizations {
+		organization = strings.ToLower(strings.TrimSpace(organization))
+		if organization == "" {
+			continue
+		}
+
+		if _, ok := seen[organization]; ok {
+			continue
+		}
+
+		seen[organization] = struct{}{}
+		normalized = append(normalized, organization)
+	}
+
+	return normalized
+}
+
+// UTMParametersFromQuery builds the UTM parameters of an application, skipping the ones not set or empty.
+func UTMParametersFromQuery(campaign, medium, source *string) []entities.UTMParameters {
+	var parameters []entities.UTMParameters
+
+	for _, parameter := range []struct {
+		label string
+		value *string
+	}{
+		{"utm_campaign", campaign},
+		{"utm_medium", medium},
+		{"utm_source", source},
+	} {
+		if parameter.value == nil || *parameter.value == "" {
+			continue
+		}
+
+		parameters = append(parameters, entities.UTMParameters{Label: parameter.label, Value: *parameter.value})
+	}
+
+	return parameters
+}
@@ -0,0 +1,144 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+	"github.com/Work4Labs/uservice-applications/pkg/utils"
+)
+
+func TestNormalizeOrganizations(t *testing.T) {
+	flagTestNormalizeOrganizations := []struct {
+		name string
+
+		organizations []string
+
+		expectedRes []string
+	}{
+		{
+			name: "ok",
+
+			organizations: []string{"seiza", "work4"},
+
+			expectedRes: []string{"seiza", "work4"},
+		},
+		{
+			name: "ok - trimmed and lower-cased",
+
+			organizations: []string{"  Seiza ", "WORK4"},
+
+			expectedRes: []string{"seiza", "work4"},
+		},
+		{
+			name: "ok - duplicates removed keeping the order",
+
+			organizations: []string{"work4", "seiza", "Work4"},
+
+			expectedRes: []string{"work4", "seiza"},
+		},
+		{
+			name: "ok - blank names skipped",
+
+			organizations: []string{"", "  ", "seiza"},
+
+			expectedRes: []string{"seiza"},
+		},
+		{
+			name: "edge - nil slice",
+
+			organizations: nil,
+
+			expectedRes: nil,
+		},
+		{
+			name: "edge - empty slice",
+
+			organizations: []string{},
+
+			expectedRes: nil,
+		},
+		{
+			name: "edge - only blank names",
+
+			organizations: []string{"", " "},
+
+			expectedRes: nil,
+		},
+	}
+
+	for _, tt := range flagTestNormalizeOrganizations {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			res := utils.NormalizeOrganizations(tt.organizations)
+			assert.Equal(tt.expectedRes, res)
+		})
+	}
+}
+
+func TestUTMParametersFromQuery(t *testing.T) {
+	flagTestUTMParametersFromQuery := []struct {
+		name string
+
+		campaign *string
+		medium   *string
+		source   *string
+
+		expectedRes []entities.UTMParameters
+	}{
+		{
+			name: "ok",
+
+			campaign: lo.ToPtr("12"),
+			medium:   lo.ToPtr("lead"),
+			source:   lo.ToPtr("facebook"),
+
+			expectedRes: []entities.UTMParameters{
+				{Label: "utm_campaign", Value: "12"},
+				{Label: "utm_medium", Value: "lead"},
+				{Label: "utm_source", Value: "facebook"},
+			},
+		},
+		{
+			name: "ok - some parameters missing",
+
+			campaign: nil,
+			medium:   lo.ToPtr("lead"),
+			source:   lo.ToPtr(""),
+
+			expectedRes: []entities.UTMParameters{
+				{Label: "utm_medium", Value: "lead"},
+			},
+		},
+		{
+			name: "edge - all nil",
+
+			expectedRes: nil,
+		},
+		{
+			name: "edge - all empty",
+
+			campaign: lo.ToPtr(""),
+			medium:   lo.ToPtr(""),
+			source:   lo.ToPtr(""),
+
+			expectedRes: nil,
+		},
+	}
+
+	for _, tt := range flagTestUTMParametersFromQuery {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			res := utils.UTMParametersFromQuery(tt.campaign, tt.medium, tt.source)
+			assert.Equal(tt.expectedRes, res)
+		})
+	}
+}
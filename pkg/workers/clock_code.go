@@ -0,0 +1,54 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+)
+
+var ErrExpireTaskFailed = errors.New("failed to expire task")
+
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc lets a plain function, like time.Now, be used as a Clock.
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+type TaskExpirer struct {
+	Clock         Clock
+	TaskFinalizer TaskFinalizerForWorkerPendingTaskGetter
+}
+
+func NewTaskExpirer(taskFinalizer TaskFinalizerForWorkerPendingTaskGetter) *TaskExpirer {
+	return &TaskExpirer{
+		Clock:         ClockFunc(time.Now),
+		TaskFinalizer: taskFinalizer,
+	}
+}
+
+// ExpireIfOutdated cancels the task when its upper plan ended before now, and tells whether it did.
+func (e *TaskExpirer) ExpireIfOutdated(ctx context.Context, task *entities.Task) (bool, error) {
+	now := e.Clock.Now()
+
+	if !task.Plan.Upper.Valid || !task.Plan.Upper.Time.Before(now) {
+		return false, nil
+	}
+
+	output := map[string]interface{}{
+		"error":      "task upper plan is outdated",
+		"expired_at": now,
+	}
+	if err := e.TaskFinalizer.Exec(ctx, task.ID, entities.TaskStatusCancelled, output); err != nil {
+		return false, errors.Wrap(err, ErrExpireTaskFailed)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,132 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+	"github.com/Work4Labs/uservice-applications/pkg/workers"
+	"github.com/Work4Labs/uservice-applications/pkg/workers/mocks"
+)
+
+func TestExpireIfOutdated(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		taskID = int64(1)
+	)
+
+	var (
+		// every case runs at the same frozen instant, so boundaries can be tested exactly
+		now = time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC)
+
+		errFinalizer = errors.New("failed to finalize task")
+	)
+
+	newTask := func(upper pgtype.Timestamptz) *entities.Task {
+		return &entities.Task{
+			ID:   taskID,
+			Plan: pgtype.Range[pgtype.Timestamptz]{Upper: upper},
+		}
+	}
+
+	flagTestExpireIfOutdated := []struct {
+		name string
+
+		now  time.Time
+		task *entities.Task
+
+		finalizerCalled bool
+		finalizerErr    error
+
+		expectedRes bool
+		expectedErr error
+	}{
+		{
+			name: "ok - plan still running",
+
+			now:  now,
+			task: newTask(pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true}),
+
+			expectedRes: false,
+		},
+		{
+			name: "ok - plan ends right now",
+
+			now:  now,
+			task: newTask(pgtype.Timestamptz{Time: now, Valid: true}),
+
+			expectedRes: false,
+		},
+		{
+			name: "ok - plan ended",
+
+			now:  now,
+			task: newTask(pgtype.Timestamptz{Time: now.Add(-time.Nanosecond), Valid: true}),
+
+			finalizerCalled: true,
+
+			expectedRes: true,
+		},
+		{
+			name: "ok - no upper plan",
+
+			now:  now,
+			task: newTask(pgtype.Timestamptz{Valid: false}),
+
+			expectedRes: false,
+		},
+		{
+			name: "ko - task finalizer exec",
+
+			now:  now,
+			task: newTask(pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true}),
+
+			finalizerCalled: true,
+			finalizerErr:    errFinalizer,
+
+			expectedRes: false,
+			expectedErr: errFinalizer,
+		},
+	}
+
+	for _, tt := range flagTestExpireIfOutdated {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			taskFinalizer := &mocks.TaskFinalizerForWorkerPendingTaskGetter{}
+			taskFinalizer.On(
+				"Exec",
+				mock.Anything,
+				taskID,
+				entities.TaskStatusCancelled,
+				map[string]interface{}{
+					"error":      "task upper plan is outdated",
+					"expired_at": tt.now,
+				},
+			).Return(tt.finalizerErr)
+
+			expirer := workers.NewTaskExpirer(taskFinalizer)
+			// freeze the time for this case
+			expirer.Clock = workers.ClockFunc(func() time.Time { return tt.now })
+
+			res, err := expirer.ExpireIfOutdated(ctx, tt.task)
+			assert.ErrorIs(err, tt.expectedErr)
+			assert.Equal(tt.expectedRes, res)
+
+			if tt.finalizerCalled {
+				taskFinalizer.AssertExpectations(t)
+			} else {
+				taskFinalizer.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+
+	"github.com/Work4Labs/uservice-applications/pkg/database"
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+)
+
+var (
+	ErrGetNextPendingTaskFailed = errors.New("failed to get next pending task")
+	ErrGetTaskHistory           = errors.New("failed to get task history")
+	ErrStartTaskFailed          = errors.New("failed to start task")
+	ErrTaskOutdated             = errors.New("task upper plan is outdated")
+	ErrTaskDuplicated           = errors.New("task already ran")
+)
+
+type PgxConn interface {
+	Rollback(ctx context.Context) error
+	Commit(ctx context.Context) error
+}
+
+type TaskDAOForWorkerTaskStarter interface {
+	GetNextPendingTask(ctx context.Context) (*entities.Task, error)
+}
+
+type TaskExecutionHistoryDAOForWorkerTaskStarter interface {
+	Contains(ctx context.Context, workflowName, taskName, uniqueTaskParams string) (bool, error)
+}
+
+type TaskFinalizerForWorkerPendingTaskGetter interface {
+	Exec(ctx context.Context, ID int64, status entities.TaskStatus, output interface{}) error
+}
+
+type TaskStarter interface {
+	Exec(ctx context.Context, ID int64, workerUUID uuid.UUID) error
+}
+
+type WorkerPendingTaskGetterDependencies struct {
+	Conn                 PgxConn
+	TaskDAO              TaskDAOForWorkerTaskStarter
+	TaskStarter          TaskStarter
+	TaskFinalizer        TaskFinalizerForWorkerPendingTaskGetter
+	TaskExecutionHistory TaskExecutionHistoryDAOForWorkerTaskStarter
+}
+
+type Worker struct {
+	PrepareNextTaskToRunDeps func(ctx context.Context) (*WorkerPendingTaskGetterDependencies, error)
+}
+
+func New() *Worker {
+	return &Worker{
+		PrepareNextTaskToRunDeps: InitializeWorkerPendingTaskGetterDependencies,
+	}
+}
+
+// PrepareNextTaskToRun gets the next task to run and sets its status to RUNNING
+func (w *Worker) PrepareNextTaskToRun(ctx context.Context, workerUUID uuid.UUID) (*entities.Task, error) {
+	deps, err := w.PrepareNextTaskToRunDeps(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		database.CleanTx(ctx, deps.Conn, err)
+	}()
+
+	task, err := deps.TaskDAO.GetNextPendingTask(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrGetNextPendingTaskFailed)
+	}
+
+	task.WorkerUUID = &workerUUID
+
+	// if the task upper plan is out-dated, the task should be cancelled.
+	if task.Plan.Upper.Valid && task.Plan.Upper.Time.Before(time.Now()) {
+		output := map[string]interface{}{
+			"error": "task upper plan is outdated",
+		}
+		if err := deps.TaskFinalizer.Exec(ctx, task.ID, entities.TaskStatusCancelled, output); err != nil {
+			return nil, errors.Wrap(err, ErrGetNextPendingTaskFailed)
+		}
+
+		return nil, errors.Wrap(ErrTaskOutdated, ErrGetNextPendingTaskFailed)
+	}
+
+	// if another task with the same name and parameters already ran, we cancel it too.
+	workflowName, taskName, _ := entities.UnmarshalTaskName(task.Name)
+	taskAlreadyRan, err := deps.TaskExecutionHistory.Contains(ctx, workflowName, taskName, task.UniqueParams)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrGetTaskHistory)
+	}
+
+	if taskAlreadyRan {
+		output := map[string]interface{}{
+			"error": "task already ran",
+		}
+		if err := deps.TaskFinalizer.Exec(ctx, task.ID, entities.TaskStatusDuplicated, output); err != nil {
+			return nil, errors.Wrap(err, ErrGetNextPendingTaskFailed)
+		}
+
+		return nil, errors.Wrap(ErrTaskDuplicated, ErrGetNextPendingTaskFailed)
+	}
+
+	err = deps.TaskStarter.Exec(ctx, task.ID, workerUUID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrStartTaskFailed)
+	}
+
+	return task, nil
+}
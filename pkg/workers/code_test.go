@@ -0,0 +1,236 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Work4Labs/uservice-applications/pkg/entities"
+	"github.com/Work4Labs/uservice-applications/pkg/workers"
+	"github.com/Work4Labs/uservice-applications/pkg/workers/mocks"
+)
+
+func TestPrepareNextTaskToRun(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		taskID       = int64(1)
+		workflowName = "workflow"
+		taskName     = "task"
+		uniqueParams = `{"application_id":"00000000-0000-0000-0000-000000000010"}`
+	)
+
+	var (
+		workerUUID = uuid.MustParse("00000000-0000-0000-0000-000000000011")
+
+		errDAO       = errors.New("failed to get task")
+		errHistory   = errors.New("failed to read history")
+		errFinalizer = errors.New("failed to finalize task")
+		errStarter   = errors.New("failed to start task")
+	)
+
+	// newTask builds a fresh task for each case, PrepareNextTaskToRun mutates it.
+	newTask := func(upper time.Time) *entities.Task {
+		return &entities.Task{
+			ID:           taskID,
+			Name:         entities.MarshalTaskName(workflowName, taskName),
+			UniqueParams: uniqueParams,
+			Plan: pgtype.Range[pgtype.Timestamptz]{
+				Upper: pgtype.Timestamptz{Time: upper, Valid: true},
+			},
+		}
+	}
+
+	flagTestPrepareNextTaskToRun := []struct {
+		name string
+
+		depsErr error
+
+		taskRes *entities.Task
+		taskErr error
+
+		historyRes bool
+		historyErr error
+
+		finalizerStatus *entities.TaskStatus
+		finalizerErr    error
+
+		starterErr error
+
+		expectedTask  bool
+		expectedErr   error
+		expectedPanic bool
+
+		commitCalled *bool
+	}{
+		{
+			name: "ok",
+
+			taskRes: newTask(time.Now().Add(time.Hour)),
+
+			historyRes: false,
+
+			expectedTask: true,
+			commitCalled: lo.ToPtr(true),
+		},
+		{
+			name: "ko - prepare next task to run deps panics",
+
+			depsErr: errors.New("failed to init dependencies"),
+
+			expectedPanic: true,
+		},
+		{
+			name: "ko - task dao get next pending task",
+
+			taskErr: errDAO,
+
+			expectedErr:  errDAO,
+			commitCalled: lo.ToPtr(false),
+		},
+		{
+			name: "ko - task outdated",
+
+			taskRes: newTask(time.Now().Add(-time.Hour)),
+
+			finalizerStatus: lo.ToPtr(entities.TaskStatusCancelled),
+
+			expectedErr:  workers.ErrTaskOutdated,
+			commitCalled: lo.ToPtr(true),
+		},
+		{
+			name: "ko - task outdated and task finalizer exec",
+
+			taskRes: newTask(time.Now().Add(-time.Hour)),
+
+			finalizerStatus: lo.ToPtr(entities.TaskStatusCancelled),
+			finalizerErr:    errFinalizer,
+
+			expectedErr: errFinalizer,
+		},
+		{
+			name: "ko - task execution history contains",
+
+			taskRes: newTask(time.Now().Add(time.Hour)),
+
+			historyErr: errHistory,
+
+			expectedErr:  errHistory,
+			commitCalled: lo.ToPtr(false),
+		},
+		{
+			name: "ko - task duplicated",
+
+			taskRes: newTask(time.Now().Add(time.Hour)),
+
+			historyRes: true,
+
+			finalizerStatus: lo.ToPtr(entities.TaskStatusDuplicated),
+
+			expectedErr:  workers.ErrTaskDuplicated,
+			commitCalled: lo.ToPtr(true),
+		},
+		{
+			name: "ko - task duplicated and task finalizer exec",
+
+			taskRes: newTask(time.Now().Add(time.Hour)),
+
+			historyRes: true,
+
+			finalizerStatus: lo.ToPtr(entities.TaskStatusDuplicated),
+			finalizerErr:    errFinalizer,
+
+			expectedErr: errFinalizer,
+		},
+		{
+			name: "ko - task starter exec",
+
+			taskRes: newTask(time.Now().Add(time.Hour)),
+
+			historyRes: false,
+
+			starterErr: errStarter,
+
+			expectedErr:  errStarter,
+			commitCalled: lo.ToPtr(false),
+		},
+	}
+
+	for _, tt := range flagTestPrepareNextTaskToRun {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			conn := &mocks.PgxConn{}
+			conn.On("Commit", mock.Anything).Return(nil)
+			conn.On("Rollback", mock.Anything).Return(nil)
+
+			taskDAO := &mocks.TaskDAOForWorkerTaskStarter{}
+			taskDAO.On("GetNextPendingTask", mock.Anything).Return(tt.taskRes, tt.taskErr)
+
+			taskHistory := &mocks.TaskExecutionHistoryDAOForWorkerTaskStarter{}
+			taskHistory.On("Contains", mock.Anything, workflowName, taskName, uniqueParams).Return(tt.historyRes, tt.historyErr)
+
+			taskFinalizer := &mocks.TaskFinalizerForWorkerPendingTaskGetter{}
+			taskFinalizer.On("Exec", mock.Anything, taskID, mock.Anything, mock.Anything).Return(tt.finalizerErr)
+
+			taskStarter := &mocks.TaskStarter{}
+			taskStarter.On("Exec", mock.Anything, taskID, workerUUID).Return(tt.starterErr)
+
+			worker := &workers.Worker{
+				PrepareNextTaskToRunDeps: func(ctx context.Context) (*workers.WorkerPendingTaskGetterDependencies, error) {
+					return &workers.WorkerPendingTaskGetterDependencies{
+						Conn:                 conn,
+						TaskDAO:              taskDAO,
+						TaskStarter:          taskStarter,
+						TaskFinalizer:        taskFinalizer,
+						TaskExecutionHistory: taskHistory,
+					}, tt.depsErr
+				},
+			}
+
+			if tt.expectedPanic {
+				assert.Panics(func() {
+					_, _ = worker.PrepareNextTaskToRun(ctx, workerUUID)
+				})
+				taskDAO.AssertNotCalled(t, "GetNextPendingTask", mock.Anything)
+
+				return
+			}
+
+			task, err := worker.PrepareNextTaskToRun(ctx, workerUUID)
+			assert.ErrorIs(err, tt.expectedErr)
+
+			if tt.expectedTask {
+				assert.Equal(tt.taskRes, task)
+				assert.Equal(workerUUID, *task.WorkerUUID)
+				taskStarter.AssertCalled(t, "Exec", mock.Anything, taskID, workerUUID)
+			} else {
+				assert.Nil(task)
+			}
+
+			if tt.finalizerStatus != nil {
+				taskFinalizer.AssertCalled(t, "Exec", mock.Anything, taskID, *tt.finalizerStatus, mock.Anything)
+				taskStarter.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				taskFinalizer.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			if tt.commitCalled != nil {
+				if *tt.commitCalled {
+					conn.AssertCalled(t, "Commit", mock.Anything)
+				} else {
+					conn.AssertCalled(t, "Rollback", mock.Anything)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package workers
+
+import (
+	"context"
+	"errors"
+)
+
+// in the services, wire generates the injectors from their providers, see pkg/di. The examples only need their signatures.
+var errNotInjected = errors.New("dependencies are not injected in the examples")
+
+func InitializeWorkerPendingTaskGetterDependencies(ctx context.Context) (*WorkerPendingTaskGetterDependencies, error) {
+	return nil, errNotInjected
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/Work4Labs/uservice-applications/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaskDAOForWorkerTaskStarter is an autogenerated mock type for the TaskDAOForWorkerTaskStarter type
+type TaskDAOForWorkerTaskStarter struct {
+	mock.Mock
+}
+
+// GetNextPendingTask provides a mock function with given fields: ctx
+func (_m *TaskDAOForWorkerTaskStarter) GetNextPendingTask(ctx context.Context) (*entities.Task, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextPendingTask")
+	}
+
+	var r0 *entities.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entities.Task, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entities.Task); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTaskDAOForWorkerTaskStarter creates a new instance of TaskDAOForWorkerTaskStarter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskDAOForWorkerTaskStarter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskDAOForWorkerTaskStarter {
+	mock := &TaskDAOForWorkerTaskStarter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
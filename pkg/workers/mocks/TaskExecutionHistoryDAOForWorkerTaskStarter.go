@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaskExecutionHistoryDAOForWorkerTaskStarter is an autogenerated mock type for the TaskExecutionHistoryDAOForWorkerTaskStarter type
+type TaskExecutionHistoryDAOForWorkerTaskStarter struct {
+	mock.Mock
+}
+
+// Contains provides a mock function with given fields: ctx, workflowName, taskName, uniqueTaskParams
+func (_m *TaskExecutionHistoryDAOForWorkerTaskStarter) Contains(ctx context.Context, workflowName string, taskName string, uniqueTaskParams string) (bool, error) {
+	ret := _m.Called(ctx, workflowName, taskName, uniqueTaskParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Contains")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return rf(ctx, workflowName, taskName, uniqueTaskParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = rf(ctx, workflowName, taskName, uniqueTaskParams)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, workflowName, taskName, uniqueTaskParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTaskExecutionHistoryDAOForWorkerTaskStarter creates a new instance of TaskExecutionHistoryDAOForWorkerTaskStarter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskExecutionHistoryDAOForWorkerTaskStarter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskExecutionHistoryDAOForWorkerTaskStarter {
+	mock := &TaskExecutionHistoryDAOForWorkerTaskStarter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
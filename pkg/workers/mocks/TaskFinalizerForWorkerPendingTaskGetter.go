@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/Work4Labs/uservice-applications/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaskFinalizerForWorkerPendingTaskGetter is an autogenerated mock type for the TaskFinalizerForWorkerPendingTaskGetter type
+type TaskFinalizerForWorkerPendingTaskGetter struct {
+	mock.Mock
+}
+
+// Exec provides a mock function with given fields: ctx, ID, status, output
+func (_m *TaskFinalizerForWorkerPendingTaskGetter) Exec(ctx context.Context, ID int64, status entities.TaskStatus, output interface{}) error {
+	ret := _m.Called(ctx, ID, status, output)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entities.TaskStatus, interface{}) error); ok {
+		r0 = rf(ctx, ID, status, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTaskFinalizerForWorkerPendingTaskGetter creates a new instance of TaskFinalizerForWorkerPendingTaskGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskFinalizerForWorkerPendingTaskGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskFinalizerForWorkerPendingTaskGetter {
+	mock := &TaskFinalizerForWorkerPendingTaskGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
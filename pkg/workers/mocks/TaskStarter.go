@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaskStarter is an autogenerated mock type for the TaskStarter type
+type TaskStarter struct {
+	mock.Mock
+}
+
+// Exec provides a mock function with given fields: ctx, ID, workerUUID
+func (_m *TaskStarter) Exec(ctx context.Context, ID int64, workerUUID uuid.UUID) error {
+	ret := _m.Called(ctx, ID, workerUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) error); ok {
+		r0 = rf(ctx, ID, workerUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTaskStarter creates a new instance of TaskStarter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskStarter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskStarter {
+	mock := &TaskStarter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
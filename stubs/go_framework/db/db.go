@@ -0,0 +1,28 @@
+// Package db is a stub of the go_framework db package, with what the examples use.
+package db
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// CleanTx commits the transaction when err is nil, and rolls it back otherwise.
+func CleanTx(ctx context.Context, tx Tx, err error) {
+	if err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			log.WithError(rollbackErr).Error("failed to rollback transaction")
+		}
+
+		return
+	}
+
+	if commitErr := tx.Commit(ctx); commitErr != nil {
+		log.WithError(commitErr).Error("failed to commit transaction")
+	}
+}
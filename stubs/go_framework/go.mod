@@ -0,0 +1,7 @@
+module github.com/Work4Labs/go_framework
+
+go 1.26.0
+
+require github.com/sirupsen/logrus v1.10.2
+
+require golang.org/x/sys v0.13.0 // indirect
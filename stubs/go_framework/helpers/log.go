@@ -0,0 +1,26 @@
+// Package helpers is a stub of the go_framework helpers package, with what the examples use.
+package helpers
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type loggerKey struct{}
+
+// ContextWithLog returns a logger with the fields, and a context carrying it for the functions called with it.
+func ContextWithLog(ctx context.Context, fields log.Fields) (context.Context, *log.Entry) {
+	logger := LoggerFromContext(ctx).WithFields(fields)
+
+	return context.WithValue(ctx, loggerKey{}, logger), logger
+}
+
+// LoggerFromContext returns the logger set by ContextWithLog, or the standard one.
+func LoggerFromContext(ctx context.Context) *log.Entry {
+	if logger, ok := ctx.Value(loggerKey{}).(*log.Entry); ok {
+		return logger
+	}
+
+	return log.NewEntry(log.StandardLogger())
+}
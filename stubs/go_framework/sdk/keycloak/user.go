@@ -0,0 +1,10 @@
+// Package keycloak is a stub of the go_framework keycloak sdk, with what the examples use.
+package keycloak
+
+// JWTUser is the user authenticated by a keycloak token.
+type JWTUser struct {
+	ID              string
+	IsAuthenticated bool
+	JWT             string
+	Groups          []string
+}
@@ -0,0 +1,3 @@
+module github.com/Work4Labs/go_models
+
+go 1.26.0
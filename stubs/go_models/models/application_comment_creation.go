@@ -0,0 +1,17 @@
+// Package models is a stub of the go_models models shared between the services, with what the examples use.
+package models
+
+// ApplicationCommentCreation application comment creation
+type ApplicationCommentCreation struct {
+
+	// content
+	// Required: true
+	Content string `json:"content"`
+
+	// is bulk action
+	IsBulkAction bool `json:"is_bulk_action"`
+
+	// kind
+	// Required: true
+	Kind string `json:"kind"`
+}
@@ -0,0 +1,11 @@
+package models
+
+// ApplicationAnswer application answer
+type ApplicationAnswer struct {
+
+	// answer
+	Answer string `json:"answer"`
+
+	// question label
+	QuestionLabel string `json:"question_label"`
+}
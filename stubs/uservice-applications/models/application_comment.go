@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/go-openapi/strfmt"
+)
+
+// ApplicationComment application comment
+type ApplicationComment struct {
+
+	// content
+	Content string `json:"content"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at"`
+
+	// is bulk action
+	IsBulkAction bool `json:"is_bulk_action"`
+
+	// kind
+	Kind string `json:"kind"`
+
+	// user entity id
+	UserEntityID string `json:"user_entity_id"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"github.com/go-openapi/strfmt"
+)
+
+// ApplicationCreation application creation
+type ApplicationCreation struct {
+
+	// answers
+	Answers []*ApplicationAnswerCreation `json:"answers"`
+
+	// campaign id
+	// Required: true
+	CampaignID string `json:"campaign_id"`
+
+	// candidate id
+	// Required: true
+	CandidateID string `json:"candidate_id"`
+
+	// job id
+	// Required: true
+	// Format: uuid
+	JobID strfmt.UUID `json:"job_id"`
+
+	// organization name
+	// Required: true
+	OrganizationName string `json:"organization_name"`
+}
+
+// ApplicationAnswerCreation application answer creation
+type ApplicationAnswerCreation struct {
+
+	// answer
+	// Required: true
+	Answer string `json:"answer"`
+
+	// question label
+	// Required: true
+	QuestionLabel string `json:"question_label"`
+}
@@ -0,0 +1,29 @@
+package models
+
+// ApplicationDetails application details
+type ApplicationDetails struct {
+
+	// answers
+	Answers []*ApplicationAnswer `json:"answers"`
+
+	// campaign id
+	CampaignID string `json:"campaign_id"`
+
+	// candidate id
+	CandidateID string `json:"candidate_id"`
+
+	// comments
+	Comments []*ApplicationComment `json:"comments"`
+
+	// id
+	ID string `json:"id"`
+
+	// job id
+	JobID string `json:"job_id"`
+
+	// organization name
+	OrganizationName string `json:"organization_name"`
+
+	// statuses
+	Statuses []*ApplicationStatus `json:"statuses"`
+}
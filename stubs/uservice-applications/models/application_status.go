@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/go-openapi/strfmt"
+)
+
+// ApplicationStatus application status
+type ApplicationStatus struct {
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at"`
+
+	// id
+	ID string `json:"id"`
+
+	// is bulk action
+	IsBulkAction bool `json:"is_bulk_action"`
+
+	// label
+	Label string `json:"label"`
+
+	// user entity id
+	UserEntityID string `json:"user_entity_id"`
+}
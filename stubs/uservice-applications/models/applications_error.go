@@ -0,0 +1,4 @@
+package models
+
+// ApplicationsError applications error
+type ApplicationsError string
@@ -0,0 +1,2 @@
+// Package models is a stub of the models go-swagger generates from the swagger spec of the service, with what the examples use.
+package models
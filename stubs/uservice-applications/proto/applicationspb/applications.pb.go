@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: applications.proto
+
+package applicationspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateApplicationCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserEntityId  string                 `protobuf:"bytes,3,opt,name=user_entity_id,json=userEntityId,proto3" json:"user_entity_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	IsBulkAction  bool                   `protobuf:"varint,5,opt,name=is_bulk_action,json=isBulkAction,proto3" json:"is_bulk_action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCommentRequest) Reset() {
+	*x = CreateApplicationCommentRequest{}
+	mi := &file_applications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCommentRequest) ProtoMessage() {}
+
+func (x *CreateApplicationCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_applications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCommentRequest) Descriptor() ([]byte, []int) {
+	return file_applications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateApplicationCommentRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetUserEntityId() string {
+	if x != nil {
+		return x.UserEntityId
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetIsBulkAction() bool {
+	if x != nil {
+		return x.IsBulkAction
+	}
+	return false
+}
+
+type CreateApplicationCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCommentResponse) Reset() {
+	*x = CreateApplicationCommentResponse{}
+	mi := &file_applications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCommentResponse) ProtoMessage() {}
+
+func (x *CreateApplicationCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_applications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCommentResponse) Descriptor() ([]byte, []int) {
+	return file_applications_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateApplicationCommentResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_applications_proto protoreflect.FileDescriptor
+
+const file_applications_proto_rawDesc = "" +
+	"\n" +
+	"\x12applications.proto\x12\fapplications\"\xc2\x01\n" +
+	"\x1fCreateApplicationCommentRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12$\n" +
+	"\x0euser_entity_id\x18\x03 \x01(\tR\fuserEntityId\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12$\n" +
+	"\x0eis_bulk_action\x18\x05 \x01(\bR\fisBulkAction\"2\n" +
+	" CreateApplicationCommentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\x89\x01\n" +
+	"\fApplications\x12y\n" +
+	"\x18CreateApplicationComment\x12-.applications.CreateApplicationCommentRequest\x1a..applications.CreateApplicationCommentResponseBAZ?github.com/Work4Labs/uservice-applications/proto/applicationspbb\x06proto3"
+
+var (
+	file_applications_proto_rawDescOnce sync.Once
+	file_applications_proto_rawDescData []byte
+)
+
+func file_applications_proto_rawDescGZIP() []byte {
+	file_applications_proto_rawDescOnce.Do(func() {
+		file_applications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_applications_proto_rawDesc), len(file_applications_proto_rawDesc)))
+	})
+	return file_applications_proto_rawDescData
+}
+
+var file_applications_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_applications_proto_goTypes = []any{
+	(*CreateApplicationCommentRequest)(nil),  // 0: applications.CreateApplicationCommentRequest
+	(*CreateApplicationCommentResponse)(nil), // 1: applications.CreateApplicationCommentResponse
+}
+var file_applications_proto_depIdxs = []int32{
+	0, // 0: applications.Applications.CreateApplicationComment:input_type -> applications.CreateApplicationCommentRequest
+	1, // 1: applications.Applications.CreateApplicationComment:output_type -> applications.CreateApplicationCommentResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_applications_proto_init() }
+func file_applications_proto_init() {
+	if File_applications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_applications_proto_rawDesc), len(file_applications_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_applications_proto_goTypes,
+		DependencyIndexes: file_applications_proto_depIdxs,
+		MessageInfos:      file_applications_proto_msgTypes,
+	}.Build()
+	File_applications_proto = out.File
+	file_applications_proto_goTypes = nil
+	file_applications_proto_depIdxs = nil
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: applications.proto
+
+package applicationspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Applications_CreateApplicationComment_FullMethodName = "/applications.Applications/CreateApplicationComment"
+)
+
+// ApplicationsClient is the client API for Applications service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApplicationsClient interface {
+	CreateApplicationComment(ctx context.Context, in *CreateApplicationCommentRequest, opts ...grpc.CallOption) (*CreateApplicationCommentResponse, error)
+}
+
+type applicationsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApplicationsClient(cc grpc.ClientConnInterface) ApplicationsClient {
+	return &applicationsClient{cc}
+}
+
+func (c *applicationsClient) CreateApplicationComment(ctx context.Context, in *CreateApplicationCommentRequest, opts ...grpc.CallOption) (*CreateApplicationCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApplicationCommentResponse)
+	err := c.cc.Invoke(ctx, Applications_CreateApplicationComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationsServer is the server API for Applications service.
+// All implementations must embed UnimplementedApplicationsServer
+// for forward compatibility.
+type ApplicationsServer interface {
+	CreateApplicationComment(context.Context, *CreateApplicationCommentRequest) (*CreateApplicationCommentResponse, error)
+	mustEmbedUnimplementedApplicationsServer()
+}
+
+// UnimplementedApplicationsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApplicationsServer struct{}
+
+func (UnimplementedApplicationsServer) CreateApplicationComment(context.Context, *CreateApplicationCommentRequest) (*CreateApplicationCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApplicationComment not implemented")
+}
+func (UnimplementedApplicationsServer) mustEmbedUnimplementedApplicationsServer() {}
+func (UnimplementedApplicationsServer) testEmbeddedByValue()                      {}
+
+// UnsafeApplicationsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApplicationsServer will
+// result in compilation errors.
+type UnsafeApplicationsServer interface {
+	mustEmbedUnimplementedApplicationsServer()
+}
+
+func RegisterApplicationsServer(s grpc.ServiceRegistrar, srv ApplicationsServer) {
+	// If the following call panics, it indicates UnimplementedApplicationsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Applications_ServiceDesc, srv)
+}
+
+func _Applications_CreateApplicationComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).CreateApplicationComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Applications_CreateApplicationComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).CreateApplicationComment(ctx, req.(*CreateApplicationCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Applications_ServiceDesc is the grpc.ServiceDesc for Applications service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Applications_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "applications.Applications",
+	HandlerType: (*ApplicationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApplicationComment",
+			Handler:    _Applications_CreateApplicationComment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "applications.proto",
+}
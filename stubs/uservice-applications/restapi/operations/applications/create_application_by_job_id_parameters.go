@@ -0,0 +1,39 @@
+package applications
+
+import (
+	"net/http"
+
+	"github.com/Work4Labs/uservice-applications/models"
+)
+
+// CreateApplicationByJobIDParams contains all the bound params for the create application by job ID operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createApplicationByJobID
+type CreateApplicationByJobIDParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: header
+	*/
+	RequestID *string
+	/*
+	  Required: true
+	  In: body
+	*/
+	Application *models.ApplicationCreation
+	/*
+	  In: query
+	*/
+	UtmCampaign *string
+	/*
+	  In: query
+	*/
+	UtmMedium *string
+	/*
+	  In: query
+	*/
+	UtmSource *string
+}
@@ -0,0 +1,32 @@
+package applications
+
+import (
+	"net/http"
+
+	"github.com/Work4Labs/go_models/models"
+)
+
+// CreateApplicationCommentParams contains all the bound params for the create application comment operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createApplicationComment
+type CreateApplicationCommentParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: header
+	*/
+	RequestID *string
+	/*
+	  Required: true
+	  In: path
+	*/
+	ApplicationID string
+	/*
+	  Required: true
+	  In: body
+	*/
+	Comment *models.ApplicationCommentCreation
+}
@@ -0,0 +1,52 @@
+package applications
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/Work4Labs/uservice-applications/models"
+)
+
+// CreateApplicationInternalServerErrorCode is the HTTP code returned for type CreateApplicationInternalServerError
+const CreateApplicationInternalServerErrorCode int = 500
+
+/*
+CreateApplicationInternalServerError internal server error
+
+swagger:response createApplicationInternalServerError
+*/
+type CreateApplicationInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ApplicationsError `json:"body,omitempty"`
+}
+
+// NewCreateApplicationInternalServerError creates CreateApplicationInternalServerError with default headers values
+func NewCreateApplicationInternalServerError() *CreateApplicationInternalServerError {
+
+	return &CreateApplicationInternalServerError{}
+}
+
+// WithPayload adds the payload to the create application internal server error response
+func (o *CreateApplicationInternalServerError) WithPayload(payload models.ApplicationsError) *CreateApplicationInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create application internal server error response
+func (o *CreateApplicationInternalServerError) SetPayload(payload models.ApplicationsError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateApplicationInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
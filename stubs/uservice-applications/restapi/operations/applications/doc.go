@@ -0,0 +1,3 @@
+// Package applications is a stub of the operations go-swagger generates from the swagger spec of the service,
+// with the parameters and responses the examples use.
+package applications
@@ -0,0 +1,25 @@
+package applications
+
+import (
+	"net/http"
+)
+
+// GetApplicationParams contains all the bound params for the get application operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getApplication
+type GetApplicationParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: header
+	*/
+	RequestID *string
+	/*
+	  Required: true
+	  In: path
+	*/
+	ApplicationID string
+}
@@ -0,0 +1,140 @@
+package applications
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/Work4Labs/uservice-applications/models"
+)
+
+// GetApplicationOKCode is the HTTP code returned for type GetApplicationOK
+const GetApplicationOKCode int = 200
+
+/*
+GetApplicationOK the application
+
+swagger:response getApplicationOK
+*/
+type GetApplicationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ApplicationDetails `json:"body,omitempty"`
+}
+
+// NewGetApplicationOK creates GetApplicationOK with default headers values
+func NewGetApplicationOK() *GetApplicationOK {
+
+	return &GetApplicationOK{}
+}
+
+// WithPayload adds the payload to the get application o k response
+func (o *GetApplicationOK) WithPayload(payload *models.ApplicationDetails) *GetApplicationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get application o k response
+func (o *GetApplicationOK) SetPayload(payload *models.ApplicationDetails) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetApplicationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetApplicationNotFoundCode is the HTTP code returned for type GetApplicationNotFound
+const GetApplicationNotFoundCode int = 404
+
+/*
+GetApplicationNotFound application not found
+
+swagger:response getApplicationNotFound
+*/
+type GetApplicationNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ApplicationsError `json:"body,omitempty"`
+}
+
+// NewGetApplicationNotFound creates GetApplicationNotFound with default headers values
+func NewGetApplicationNotFound() *GetApplicationNotFound {
+
+	return &GetApplicationNotFound{}
+}
+
+// WithPayload adds the payload to the get application not found response
+func (o *GetApplicationNotFound) WithPayload(payload models.ApplicationsError) *GetApplicationNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get application not found response
+func (o *GetApplicationNotFound) SetPayload(payload models.ApplicationsError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetApplicationNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetApplicationInternalServerErrorCode is the HTTP code returned for type GetApplicationInternalServerError
+const GetApplicationInternalServerErrorCode int = 500
+
+/*
+GetApplicationInternalServerError internal server error
+
+swagger:response getApplicationInternalServerError
+*/
+type GetApplicationInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ApplicationsError `json:"body,omitempty"`
+}
+
+// NewGetApplicationInternalServerError creates GetApplicationInternalServerError with default headers values
+func NewGetApplicationInternalServerError() *GetApplicationInternalServerError {
+
+	return &GetApplicationInternalServerError{}
+}
+
+// WithPayload adds the payload to the get application internal server error response
+func (o *GetApplicationInternalServerError) WithPayload(payload models.ApplicationsError) *GetApplicationInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get application internal server error response
+func (o *GetApplicationInternalServerError) SetPayload(payload models.ApplicationsError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetApplicationInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
@@ -0,0 +1,52 @@
+package applications
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/Work4Labs/uservice-applications/models"
+)
+
+// UpdateApplicationStatusInternalServerErrorCode is the HTTP code returned for type UpdateApplicationStatusInternalServerError
+const UpdateApplicationStatusInternalServerErrorCode int = 500
+
+/*
+UpdateApplicationStatusInternalServerError internal server error
+
+swagger:response updateApplicationStatusInternalServerError
+*/
+type UpdateApplicationStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ApplicationsError `json:"body,omitempty"`
+}
+
+// NewUpdateApplicationStatusInternalServerError creates UpdateApplicationStatusInternalServerError with default headers values
+func NewUpdateApplicationStatusInternalServerError() *UpdateApplicationStatusInternalServerError {
+
+	return &UpdateApplicationStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the update application status internal server error response
+func (o *UpdateApplicationStatusInternalServerError) WithPayload(payload models.ApplicationsError) *UpdateApplicationStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update application status internal server error response
+func (o *UpdateApplicationStatusInternalServerError) SetPayload(payload models.ApplicationsError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateApplicationStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}